@@ -34,6 +34,7 @@ val services = mapOf(
     "cloudcontrol" to ServiceSpec("Cloud Control API"),
     "cloudformation" to ServiceSpec("CloudFormation", vpcLock = true),
     "cloudfront" to ServiceSpec("CloudFront"),
+    "cloudfrontkeyvaluestore" to ServiceSpec("CloudFront KeyValueStore"),
     "cloudhsmv2" to ServiceSpec("CloudHSM", vpcLock = true),
     "cloudsearch" to ServiceSpec("CloudSearch"),
     "cloudtrail" to ServiceSpec("CloudTrail"),
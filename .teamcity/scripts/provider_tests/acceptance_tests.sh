@@ -35,6 +35,7 @@ fi
 TF_ACC=1 go test \
     ./internal/acctest/... \
     ./internal/attrmap/... \
+    ./internal/auditlog/... \
     ./internal/conns/... \
     ./internal/create/... \
     ./internal/enum/... \
@@ -43,9 +44,11 @@ TF_ACC=1 go test \
     ./internal/experimental/... \
     ./internal/flex/... \
     ./internal/framework/... \
+    ./internal/function/... \
     ./internal/generate/... \
     ./internal/maps/... \
     ./internal/provider/... \
+    ./internal/ratelimit/... \
     ./internal/sdktypes/... \
     ./internal/slices/... \
     ./internal/sweep/... \
@@ -53,5 +56,6 @@ TF_ACC=1 go test \
     ./internal/tfresource/... \
     ./internal/types/... \
     ./internal/vault/... \
+    ./internal/vcr/... \
     ./internal/verify/... \
     -json -v -count=1 -parallel "%ACCTEST_PARALLELISM%" -timeout=0 -run=TestAcc
@@ -7,6 +7,7 @@ set -euo pipefail
 go test \
     ./internal/acctest/... \
     ./internal/attrmap/... \
+    ./internal/auditlog/... \
     ./internal/conns/... \
     ./internal/create/... \
     ./internal/enum/... \
@@ -15,9 +16,11 @@ go test \
     ./internal/experimental/... \
     ./internal/flex/... \
     ./internal/framework/... \
+    ./internal/function/... \
     ./internal/generate/... \
     ./internal/maps/... \
     ./internal/provider/... \
+    ./internal/ratelimit/... \
     ./internal/sdktypes/... \
     ./internal/slices/... \
     ./internal/sweep/... \
@@ -25,5 +28,6 @@ go test \
     ./internal/tfresource/... \
     ./internal/types/... \
     ./internal/vault/... \
+    ./internal/vcr/... \
     ./internal/verify/... \
     -json
@@ -47,6 +47,7 @@ variable "service_labels" {
     "clouddirectory",
     "cloudformation",
     "cloudfront",
+    "cloudfrontkeyvaluestore",
     "cloudhsmv2",
     "cloudsearch",
     "cloudsearchdomain",
@@ -186,7 +187,6 @@ variable "service_labels" {
     "lookoutmetrics",
     "lookoutvision",
     "machinelearning",
-    "macie",
     "macie2",
     "managedblockchain",
     "marketplacecatalog",
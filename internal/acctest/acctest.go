@@ -28,11 +28,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -68,6 +70,9 @@ const (
 	// Provider name for third configuration testing
 	ProviderNameThird = "awsthird"
 
+	// Provider name for the echo provider, used to test ephemeral resources
+	ProviderNameEcho = "echo"
+
 	ResourcePrefix = "tf-acc-test"
 )
 
@@ -90,6 +95,15 @@ var (
 	ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error) = protoV5ProviderFactoriesInit(context.Background(), ProviderName)
 )
 
+// ProtoV6EchoProviderFactories is a static map containing only the echo provider instance.
+// The echo provider surfaces the values of ephemeral resources in state so they can be checked.
+// Use in conjunction with ConfigWithEchoProvider.
+var (
+	ProtoV6EchoProviderFactories map[string]func() (tfprotov6.ProviderServer, error) = map[string]func() (tfprotov6.ProviderServer, error){
+		ProviderNameEcho: echoprovider.NewProviderServer(),
+	}
+)
+
 // Provider is the "main" provider instance
 //
 // This Provider can be used in testing code for API calls without requiring
@@ -1606,6 +1620,18 @@ data "aws_service" "provider_test" {
 }
 `
 
+// ConfigWithEchoProvider returns a configuration that echoes the specified ephemeral resource's data
+// into the state of the "echo.test" resource.
+func ConfigWithEchoProvider(ephemeralResourceAddress string) string {
+	return fmt.Sprintf(`
+provider "echo" {
+  data = %[1]s
+}
+
+resource "echo" "test" {}
+`, ephemeralResourceAddress)
+}
+
 // ConfigCompose can be called to concatenate multiple strings to build test configurations
 func ConfigCompose(config ...string) string {
 	var str strings.Builder
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
+)
+
+// CheckServicePackageResourceIdentity checks that every resource in the specified service package that
+// supports transparent tagging declares a resource identity and that each declared resource identity
+// conforms to the resource's schema.
+func CheckServicePackageResourceIdentity(ctx context.Context, t *testing.T, sp conns.ServicePackage) {
+	t.Helper()
+
+	for _, v := range sp.SDKResources(ctx) {
+		if v.Tags != nil && v.Identity == nil {
+			t.Errorf("%s: no resource identity declared", v.TypeName)
+			continue
+		}
+
+		if err := provider.ValidateResourceIdentity(v); err != nil {
+			t.Errorf("%s: %s", v.TypeName, err)
+		}
+	}
+
+	for _, v := range sp.FrameworkResources(ctx) {
+		inner, err := v.Factory(ctx)
+		if err != nil {
+			t.Errorf("creating resource: %s", err)
+			continue
+		}
+
+		metadataResponse := fwresource.MetadataResponse{}
+		inner.Metadata(ctx, fwresource.MetadataRequest{}, &metadataResponse)
+		typeName := metadataResponse.TypeName
+
+		if v.Tags != nil && v.Identity == nil {
+			t.Errorf("%s: no resource identity declared", typeName)
+			continue
+		}
+
+		if v.Identity == nil {
+			continue
+		}
+
+		if err := fwprovider.ValidateResourceIdentity(ctx, v.Identity, inner); err != nil {
+			t.Errorf("%s: %s", typeName, err)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package auditlog implements an audit log of the mutating AWS API calls made by the provider.
+// Each API call is written to the audit log file as a single line of JSON.
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Record represents a single mutating AWS API call.
+type Record struct {
+	Time         time.Time `json:"time"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	Action       string    `json:"action,omitempty"` // CRUD handler, e.g. "Create".
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	DurationMS   int64     `json:"duration_ms"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+}
+
+// Logger writes audit log records.
+type Logger struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+var (
+	loggersLock sync.Mutex
+	loggers     = make(map[string]*Logger) // Keyed by absolute file path.
+)
+
+// Open returns a Logger which appends to the specified file.
+// Loggers are shared by all provider instances in the process which write to the same file.
+func Open(path string) (*Logger, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	loggersLock.Lock()
+	defer loggersLock.Unlock()
+
+	if v, ok := loggers[path]; ok {
+		return v, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log file (%s): %w", path, err)
+	}
+
+	logger := New(f)
+	loggers[path] = logger
+
+	return logger, nil
+}
+
+// New returns a Logger which writes to the specified io.Writer.
+func New(w io.Writer) *Logger {
+	return &Logger{
+		w: w,
+	}
+}
+
+// Record records a mutating AWS API call.
+// If the call was made from an audited resource CRUD handler the record is buffered until the handler completes.
+func (l *Logger) Record(ctx context.Context, r Record) error {
+	if inv, ok := FromContext(ctx); ok {
+		inv.append(r)
+
+		return nil
+	}
+
+	return l.write(r)
+}
+
+func (l *Logger) write(records ...Record) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for _, r := range records {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+
+		if _, err := l.w.Write(append(b, '\n')); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Invocation represents a single invocation of a resource's CRUD handler.
+type Invocation struct {
+	Action       string
+	ResourceID   string
+	ResourceType string
+
+	lock    sync.Mutex
+	records []Record
+}
+
+func (inv *Invocation) append(r Record) {
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+
+	inv.records = append(inv.records, r)
+}
+
+// Flush writes the invocation's buffered records, setting each record's resource information.
+func (l *Logger) Flush(inv *Invocation) error {
+	inv.lock.Lock()
+	records := inv.records
+	inv.records = nil
+	inv.lock.Unlock()
+
+	for i := range records {
+		records[i].Action = inv.Action
+		records[i].ResourceID = inv.ResourceID
+		records[i].ResourceType = inv.ResourceType
+	}
+
+	return l.write(records...)
+}
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// NewContext returns a Context enhanced with the specified CRUD handler invocation.
+func NewContext(ctx context.Context, inv *Invocation) context.Context {
+	return context.WithValue(ctx, contextKey, inv)
+}
+
+// FromContext returns the CRUD handler invocation stored in Context.
+func FromContext(ctx context.Context) (*Invocation, bool) {
+	inv, ok := ctx.Value(contextKey).(*Invocation)
+	return inv, ok
+}
+
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// IsMutating returns whether the specified AWS API operation may modify resources.
+func IsMutating(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlog_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/auditlog"
+)
+
+func TestIsMutating(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"CreateTopic":         true,
+		"DeleteBucket":        true,
+		"PutObject":           true,
+		"TagResource":         true,
+		"DescribeInstances":   false,
+		"GetTopicAttributes":  false,
+		"ListTagsForResource": false,
+		"HeadObject":          false,
+		"BatchGetItem":        false,
+	}
+
+	for operation, want := range testCases {
+		if got := auditlog.IsMutating(operation); got != want {
+			t.Errorf("IsMutating(%q) = %t, want %t", operation, got, want)
+		}
+	}
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := auditlog.New(&buf)
+
+	// Calls made outside a resource CRUD handler are written immediately.
+	ctx := context.Background()
+	if err := logger.Record(ctx, auditlog.Record{Service: "SNS", Operation: "CreateTopic"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := strings.Count(buf.String(), "\n"), 1; got != want {
+		t.Fatalf("got %d lines, want %d", got, want)
+	}
+
+	// Calls made inside a resource CRUD handler are written when the handler completes.
+	buf.Reset()
+	inv := &auditlog.Invocation{
+		Action:       "Create",
+		ResourceType: "aws_sns_topic",
+	}
+	ctx = auditlog.NewContext(ctx, inv)
+	if err := logger.Record(ctx, auditlog.Record{Service: "SNS", Operation: "CreateTopic", RequestID: "r1"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := logger.Record(ctx, auditlog.Record{Service: "SNS", Operation: "SetTopicAttributes", RequestID: "r2", ErrorCode: "InvalidParameter"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output before flush, got %q", buf.String())
+	}
+
+	inv.ResourceID = "arn:aws:sns:us-west-2:123456789012:example" //lintignore:AWSAT003,AWSAT005
+	if err := logger.Flush(inv); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("got %d lines, want %d", got, want)
+	}
+
+	for i, want := range []auditlog.Record{
+		{ResourceType: "aws_sns_topic", ResourceID: inv.ResourceID, Action: "Create", Service: "SNS", Operation: "CreateTopic", RequestID: "r1"},
+		{ResourceType: "aws_sns_topic", ResourceID: inv.ResourceID, Action: "Create", Service: "SNS", Operation: "SetTopicAttributes", RequestID: "r2", ErrorCode: "InvalidParameter"},
+	} {
+		var got auditlog.Record
+		if err := json.Unmarshal([]byte(lines[i]), &got); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != want {
+			t.Errorf("record %d = %+v, want %+v", i, got, want)
+		}
+	}
+}
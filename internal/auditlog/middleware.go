@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlog
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const middlewareID = "TerraformAuditLog"
+
+// AddToConfig adds audit logging to all AWS SDK for Go v2 API clients created from the specified configuration.
+func (l *Logger) AddToConfig(cfg *aws_sdkv2.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(middlewareID, l.handleInitialize), middleware.After)
+	})
+}
+
+func (l *Logger) handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+	if !IsMutating(operation) {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	start := time.Now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	r := Record{
+		Time:       start.UTC(),
+		Service:    awsmiddleware.GetServiceID(ctx),
+		Operation:  operation,
+		Region:     awsmiddleware.GetRegion(ctx),
+		DurationMS: time.Since(start).Milliseconds(),
+	}
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		r.RequestID = v
+	}
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			r.ErrorCode = apiErr.ErrorCode()
+		} else {
+			r.ErrorCode = "ClientError"
+		}
+	}
+
+	if err := l.Record(ctx, r); err != nil {
+		tflog.Warn(ctx, "writing audit log", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return out, metadata, err
+}
+
+// AddToSession adds audit logging to all AWS SDK for Go v1 API clients created from the specified session.
+func (l *Logger) AddToSession(sess *session_sdkv1.Session) {
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: middlewareID,
+		Fn:   l.handleComplete,
+	})
+}
+
+func (l *Logger) handleComplete(req *request.Request) {
+	if req.Operation == nil || !IsMutating(req.Operation.Name) {
+		return
+	}
+
+	ctx := req.Context()
+	r := Record{
+		Time:       req.Time.UTC(),
+		Service:    req.ClientInfo.ServiceID,
+		Operation:  req.Operation.Name,
+		RequestID:  req.RequestID,
+		DurationMS: time.Since(req.Time).Milliseconds(),
+	}
+	if v := req.Config.Region; v != nil {
+		r.Region = *v
+	}
+	if err := req.Error; err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) {
+			r.ErrorCode = awsErr.Code()
+		} else {
+			r.ErrorCode = "ClientError"
+		}
+	}
+
+	if err := l.Record(ctx, r); err != nil {
+		tflog.Warn(ctx, "writing audit log", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
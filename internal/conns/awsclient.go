@@ -11,11 +11,15 @@ import (
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	credentials_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/auditlog"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,14 +37,84 @@ type AWSClient struct {
 	Session                 *session_sdkv1.Session
 	TerraformVersion        string
 
-	awsConfig      *aws_sdkv2.Config
-	clients        map[string]any
-	conns          map[string]any
-	endpoints      map[string]string // From provider configuration.
-	httpClient     *http.Client
-	lock           sync.Mutex
-	s3UsePathStyle bool   // From provider configuration.
-	stsRegion      string // From provider configuration.
+	auditLogger     *auditlog.Logger
+	awsConfig       *aws_sdkv2.Config
+	clients         map[string]any
+	conns           map[string]any
+	endpoints       map[string]string // From provider configuration.
+	httpClient      *http.Client
+	lock            sync.Mutex
+	rateLimiters    map[string]*ratelimit.Limiter // Keyed by service package name.
+	regionalClients map[string]*AWSClient         // Keyed by Region.
+	s3UsePathStyle  bool                          // From provider configuration.
+	stsRegion       string                        // From provider configuration.
+}
+
+// AuditLogger returns the logger for mutating AWS API calls, or nil if audit logging is not configured.
+func (client *AWSClient) AuditLogger() *auditlog.Logger {
+	return client.auditLogger
+}
+
+// EffectiveRegion returns the AWS Region in which the resource in Context is managed.
+// This is the resource's Region override, if any, otherwise the provider's configured Region.
+func (client *AWSClient) EffectiveRegion(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
+
+	return client.Region
+}
+
+// ForRegion returns an AWSClient for the specified AWS Region.
+// The returned client shares this client's configuration but lazily creates its own AWS API clients.
+func (client *AWSClient) ForRegion(region string) *AWSClient {
+	if region == "" || region == client.Region {
+		return client
+	}
+
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if v, ok := client.regionalClients[region]; ok {
+		return v
+	}
+
+	v := &AWSClient{
+		AccountID:         client.AccountID,
+		DefaultTagsConfig: client.DefaultTagsConfig,
+		DNSSuffix:         client.DNSSuffix,
+		IgnoreTagsConfig:  client.IgnoreTagsConfig,
+		Partition:         client.Partition,
+		Region:            region,
+		ReverseDNSPrefix:  client.ReverseDNSPrefix,
+		ServicePackages:   client.ServicePackages,
+		TerraformVersion:  client.TerraformVersion,
+
+		auditLogger:    client.auditLogger,
+		clients:        make(map[string]any, 0),
+		conns:          make(map[string]any, 0),
+		endpoints:      client.endpoints,
+		httpClient:     client.httpClient,
+		rateLimiters:   client.rateLimiters,
+		s3UsePathStyle: client.s3UsePathStyle,
+		stsRegion:      client.stsRegion,
+	}
+
+	if client.awsConfig != nil {
+		awsConfig := client.awsConfig.Copy()
+		awsConfig.Region = region
+		v.awsConfig = &awsConfig
+	}
+	if client.Session != nil {
+		v.Session = client.Session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+	}
+
+	if client.regionalClients == nil {
+		client.regionalClients = make(map[string]*AWSClient)
+	}
+	client.regionalClients[region] = v
+
+	return v
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -64,6 +138,64 @@ func (client *AWSClient) S3ConnURICleaningDisabled(ctx context.Context) *s3_sdkv
 	return s3_sdkv1.New(client.Session.Copy(&config))
 }
 
+// S3ExpressControlConn returns an AWS SDK for Go v1 S3 API client for S3 Express One Zone
+// directory bucket management (control plane) operations.
+func (client *AWSClient) S3ExpressControlConn(ctx context.Context) *s3_sdkv1.S3 {
+	config := client.S3Conn(ctx).Config
+	if aws_sdkv1.StringValue(config.Endpoint) == "" {
+		config.Endpoint = aws_sdkv1.String(fmt.Sprintf("https://s3express-control.%s.%s", aws_sdkv1.StringValue(config.Region), client.DNSSuffix))
+	}
+	config.S3ForcePathStyle = aws_sdkv1.Bool(true)
+
+	conn := s3_sdkv1.New(client.Session.Copy(&config))
+	conn.SigningName = s3ExpressSigningName
+
+	return conn
+}
+
+// S3ExpressConn returns an AWS SDK for Go v1 S3 API client for S3 Express One Zone
+// object (data plane) operations against the zonal endpoint of the specified directory bucket.
+// Requests are authenticated using session credentials obtained from the S3 CreateSession API.
+func (client *AWSClient) S3ExpressConn(ctx context.Context, bucket string) (*s3_sdkv1.S3, error) {
+	azID, err := S3ExpressAvailabilityZoneID(bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := client.S3Conn(ctx).Config
+	if aws_sdkv1.StringValue(config.Endpoint) == "" {
+		config.Endpoint = aws_sdkv1.String(fmt.Sprintf("https://s3express-%s.%s.%s", azID, aws_sdkv1.StringValue(config.Region), client.DNSSuffix))
+		config.S3ForcePathStyle = aws_sdkv1.Bool(false)
+	}
+
+	// CreateSession is called using the caller's credentials.
+	sessionConn := s3_sdkv1.New(client.Session.Copy(&config))
+	sessionConn.SigningName = s3ExpressSigningName
+
+	credentials := credentials_sdkv1.NewCredentials(&s3ExpressSessionProvider{
+		bucket: bucket,
+		conn:   sessionConn,
+	})
+
+	conn := s3_sdkv1.New(client.Session.Copy(&config))
+	conn.SigningName = s3ExpressSigningName
+	conn.Handlers.Sign.PushFront(func(r *request_sdkv1.Request) {
+		v, err := credentials.GetWithContext(r.Context())
+
+		if err != nil {
+			r.Error = fmt.Errorf("creating S3 Express session (%s): %w", bucket, err)
+			return
+		}
+
+		// The session token is sent in its own header rather than as X-Amz-Security-Token.
+		r.HTTPRequest.Header.Set(s3ExpressSessionTokenHeader, v.SessionToken)
+		r.Config.Credentials = credentials_sdkv1.NewStaticCredentials(v.AccessKeyID, v.SecretAccessKey, "")
+	})
+
+	return conn, nil
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
@@ -136,11 +268,25 @@ func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
+	awsConfig, session := client.awsConfig, client.Session
+	if limiter, ok := client.rateLimiters[servicePackageName]; ok {
+		if awsConfig != nil {
+			v := awsConfig.Copy()
+			v.APIOptions = v.APIOptions[:len(v.APIOptions):len(v.APIOptions)] // Don't append to the shared backing array.
+			limiter.AddToConfig(&v)
+			awsConfig = &v
+		}
+		if session != nil {
+			session = session.Copy()
+			limiter.AddToSession(session)
+		}
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": client.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         client.endpoints[servicePackageName],
 		"partition":        client.Partition,
-		"session":          client.Session,
+		"session":          session,
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -153,7 +299,10 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 }
 
 // conn returns the AWS SDK for Go v1 API client for the specified service.
+// If the resource in Context has a Region override, the client is for that Region.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	c = c.ForRegion(c.EffectiveRegion(ctx))
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -202,7 +351,10 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
+// If the resource in Context has a Region override, the client is for that Region.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	c = c.ForRegion(c.EffectiveRegion(ctx))
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -11,6 +11,7 @@ import (
 	auditmanager_sdkv2 "github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	cleanrooms_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cleanrooms"
 	cloudcontrol_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	cloudfrontkeyvaluestore_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
 	cloudwatchlogs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	comprehend_sdkv2 "github.com/aws/aws-sdk-go-v2/service/comprehend"
 	computeoptimizer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
@@ -218,7 +219,6 @@ import (
 	lookoutforvision_sdkv1 "github.com/aws/aws-sdk-go/service/lookoutforvision"
 	lookoutmetrics_sdkv1 "github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	machinelearning_sdkv1 "github.com/aws/aws-sdk-go/service/machinelearning"
-	macie_sdkv1 "github.com/aws/aws-sdk-go/service/macie"
 	macie2_sdkv1 "github.com/aws/aws-sdk-go/service/macie2"
 	managedblockchain_sdkv1 "github.com/aws/aws-sdk-go/service/managedblockchain"
 	managedgrafana_sdkv1 "github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -525,6 +525,10 @@ func (c *AWSClient) CloudFrontConn(ctx context.Context) *cloudfront_sdkv1.CloudF
 	return errs.Must(conn[*cloudfront_sdkv1.CloudFront](ctx, c, names.CloudFront))
 }
 
+func (c *AWSClient) CloudFrontKeyValueStoreClient(ctx context.Context) *cloudfrontkeyvaluestore_sdkv2.Client {
+	return errs.Must(client[*cloudfrontkeyvaluestore_sdkv2.Client](ctx, c, names.CloudFrontKeyValueStore))
+}
+
 func (c *AWSClient) CloudHSMV2Conn(ctx context.Context) *cloudhsmv2_sdkv1.CloudHSMV2 {
 	return errs.Must(conn[*cloudhsmv2_sdkv1.CloudHSMV2](ctx, c, names.CloudHSMV2))
 }
@@ -1093,10 +1097,6 @@ func (c *AWSClient) MachineLearningConn(ctx context.Context) *machinelearning_sd
 	return errs.Must(conn[*machinelearning_sdkv1.MachineLearning](ctx, c, names.MachineLearning))
 }
 
-func (c *AWSClient) MacieConn(ctx context.Context) *macie_sdkv1.Macie {
-	return errs.Must(conn[*macie_sdkv1.Macie](ctx, c, names.Macie))
-}
-
 func (c *AWSClient) Macie2Conn(ctx context.Context) *macie2_sdkv1.Macie2 {
 	return errs.Must(conn[*macie2_sdkv1.Macie2](ctx, c, names.Macie2))
 }
@@ -4,7 +4,12 @@
 package conns
 
 import (
+	"context"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -90,3 +95,86 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientForRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	if got := client.ForRegion(""); got != client {
+		t.Errorf("expected same client for empty Region")
+	}
+	if got := client.ForRegion("us-west-2"); got != client { //lintignore:AWSAT003
+		t.Errorf("expected same client for provider Region")
+	}
+
+	regional := client.ForRegion("eu-west-1") //lintignore:AWSAT003
+
+	if regional == client {
+		t.Fatalf("expected different client for different Region")
+	}
+	if got, want := regional.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, expected %s", got, want)
+	}
+	if got, want := regional.AccountID, client.AccountID; got != want {
+		t.Errorf("AccountID = %s, expected %s", got, want)
+	}
+	if got, want := regional.RegionalHostname("test"), "test.eu-west-1.amazonaws.com"; got != want { //lintignore:AWSAT003
+		t.Errorf("RegionalHostname = %s, expected %s", got, want)
+	}
+	if got := client.ForRegion("eu-west-1"); got != regional { //lintignore:AWSAT003
+		t.Errorf("expected cached client for Region")
+	}
+}
+
+func TestAWSClientEffectiveRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	ctx := context.Background()
+	if got, want := client.EffectiveRegion(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("EffectiveRegion (no resource) = %s, expected %s", got, want)
+	}
+
+	ctx = NewResourceContext(ctx, "test", "Test")
+	if got, want := client.EffectiveRegion(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("EffectiveRegion (no override) = %s, expected %s", got, want)
+	}
+
+	inContext, _ := FromContext(ctx)
+	inContext.OverrideRegion = "eu-west-1"                                  //lintignore:AWSAT003
+	if got, want := client.EffectiveRegion(ctx), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("EffectiveRegion (override) = %s, expected %s", got, want)
+	}
+}
+
+func TestAWSClientAPIClientConfigRateLimit(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		awsConfig: &aws_sdkv2.Config{},
+		rateLimiters: map[string]*ratelimit.Limiter{
+			names.EC2: ratelimit.New(names.EC2, 10, 0),
+		},
+	}
+
+	cfg := client.apiClientConfig(names.EC2)["aws_sdkv2_config"].(*aws_sdkv2.Config)
+	if got, want := len(cfg.APIOptions), 1; got != want {
+		t.Errorf("rate limited APIOptions = %d, expected %d", got, want)
+	}
+
+	cfg = client.apiClientConfig(names.S3)["aws_sdkv2_config"].(*aws_sdkv2.Config)
+	if got, want := len(cfg.APIOptions), 0; got != want {
+		t.Errorf("APIOptions = %d, expected %d", got, want)
+	}
+	if got, want := len(client.awsConfig.APIOptions), 0; got != want {
+		t.Errorf("shared APIOptions = %d, expected %d", got, want)
+	}
+}
@@ -5,16 +5,22 @@ package conns
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/auditlog"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -23,6 +29,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -34,7 +41,10 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	MockCassetteDir                string
+	MockMode                       string
 	Profile                        string
+	RateLimits                     map[string]RateLimit // Keyed by service package name.
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -52,6 +62,12 @@ type Config struct {
 	UseFIPSEndpoint                bool
 }
 
+// RateLimit is the client-side rate limit for a service's AWS API requests.
+type RateLimit struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
 	awsbaseConfig := awsbase.Config{
@@ -104,6 +120,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	if c.MockMode != "" {
+		tflog.Debug(ctx, "Configuring mock mode", map[string]any{
+			"mode":         c.MockMode,
+			"cassette_dir": c.MockCassetteDir,
+		})
+		if err := configureMockMode(&awsbaseConfig, vcr.Mode(c.MockMode), c.MockCassetteDir); err != nil {
+			return nil, diag.Errorf("configuring mock mode: %s", err)
+		}
+	}
+
 	tflog.Debug(ctx, "Configuring Terraform AWS Provider")
 	ctx, cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
@@ -123,6 +149,30 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	var auditLogger *auditlog.Logger
+	if c.AuditLogPath != "" {
+		tflog.Debug(ctx, "Configuring audit log", map[string]any{
+			"path": c.AuditLogPath,
+		})
+		auditLogger, err = auditlog.Open(c.AuditLogPath)
+		if err != nil {
+			return nil, diag.Errorf("configuring audit log: %s", err)
+		}
+
+		auditLogger.AddToConfig(&cfg)
+		auditLogger.AddToSession(sess)
+	}
+
+	rateLimiters := make(map[string]*ratelimit.Limiter, len(c.RateLimits))
+	for servicePackageName, v := range c.RateLimits {
+		tflog.Debug(ctx, "Configuring rate limit", map[string]any{
+			"aws.service":         servicePackageName,
+			"requests_per_second": v.RequestsPerSecond,
+			"burst":               v.Burst,
+		})
+		rateLimiters[servicePackageName] = ratelimit.Get(servicePackageName, v.RequestsPerSecond, v.Burst)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
@@ -171,12 +221,53 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
+	client.auditLogger = auditLogger
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.rateLimiters = rateLimiters
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.stsRegion = c.STSRegion
 
 	return client, nil
 }
+
+// configureMockMode replaces the HTTP client used for AWS API calls with one that records or replays interactions.
+func configureMockMode(awsbaseConfig *awsbase.Config, mode vcr.Mode, cassetteDir string) error {
+	if cassetteDir == "" {
+		return errors.New("cassette directory is required")
+	}
+
+	var realTransport http.RoundTripper
+	if v := awsbaseConfig.HTTPClient; v != nil {
+		realTransport = v.Transport
+	} else {
+		opts, err := awsbaseConfig.HTTPTransportOptions()
+		if err != nil {
+			return err
+		}
+		realTransport = awshttp_sdkv2.NewBuildableClient().WithTransportOptions(opts).GetTransport()
+	}
+
+	transport, err := vcr.NewTransport(mode, cassetteDir, realTransport)
+	if err != nil {
+		return err
+	}
+
+	awsbaseConfig.HTTPClient = &http.Client{
+		Transport: transport,
+	}
+
+	if mode == vcr.ModeReplay {
+		// Replayed responses don't depend on credentials.
+		// Use static credentials so that resolving credentials makes no network requests.
+		awsbaseConfig.AccessKey = "mock"
+		awsbaseConfig.SecretKey = "mock"
+		awsbaseConfig.Token = ""
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.EC2MetadataServiceEnableState = imds_sdkv2.ClientDisabled
+	}
+
+	return nil
+}
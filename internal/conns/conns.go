@@ -25,6 +25,13 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an interface exported from AWS service packages
+// that implement Terraform Plugin Framework ephemeral resources.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
 type (
 	contextKeyType int
 )
@@ -35,9 +42,11 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	IsDataSource       bool   // Data source?
-	ResourceName       string // Friendly resource name, e.g. "Subnet"
-	ServicePackageName string // Canonical name defined as a constant in names package
+	IsDataSource        bool   // Data source?
+	IsEphemeralResource bool   // Ephemeral resource?
+	OverrideRegion      string // Per-resource Region override, set from the resource's top-level `region` argument
+	ResourceName        string // Friendly resource name, e.g. "Subnet"
+	ServicePackageName  string // Canonical name defined as a constant in names package
 }
 
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
@@ -50,6 +59,16 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsEphemeralResource: true,
+		ResourceName:        resourceName,
+		ServicePackageName:  servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
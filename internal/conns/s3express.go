@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	credentials_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// S3ExpressBucketNameSuffix is the suffix of all S3 Express One Zone directory bucket names.
+	S3ExpressBucketNameSuffix = "--x-s3"
+
+	s3ExpressSessionTokenHeader = "x-amz-s3session-token"
+	s3ExpressSigningName        = "s3express"
+)
+
+var s3ExpressBucketNameRegexp = regexp.MustCompile(`^([0-9a-z][0-9a-z-]*)--([0-9a-z]+-az[0-9]+)` + S3ExpressBucketNameSuffix + `$`)
+
+// S3ExpressAvailabilityZoneID returns the Availability Zone ID embedded in an S3 Express One Zone directory bucket name.
+// Directory bucket names have the form bucket-base-name--azid--x-s3.
+// An error is returned if the bucket name is not a valid directory bucket name.
+func S3ExpressAvailabilityZoneID(bucket string) (string, error) {
+	if m := s3ExpressBucketNameRegexp.FindStringSubmatch(bucket); m != nil {
+		return m[2], nil
+	}
+
+	return "", fmt.Errorf("%q is not a valid S3 directory bucket name", bucket)
+}
+
+// s3ExpressSessionProvider retrieves S3 Express One Zone session credentials for a directory bucket.
+type s3ExpressSessionProvider struct {
+	credentials_sdkv1.Expiry
+
+	bucket string
+	conn   *s3_sdkv1.S3
+}
+
+func (p *s3ExpressSessionProvider) Retrieve() (credentials_sdkv1.Value, error) {
+	return p.RetrieveWithContext(aws_sdkv1.BackgroundContext())
+}
+
+func (p *s3ExpressSessionProvider) RetrieveWithContext(ctx credentials_sdkv1.Context) (credentials_sdkv1.Value, error) {
+	output, err := p.conn.CreateSessionWithContext(ctx, &s3_sdkv1.CreateSessionInput{
+		Bucket: aws_sdkv1.String(p.bucket),
+	})
+
+	if err != nil {
+		return credentials_sdkv1.Value{}, err
+	}
+
+	if output == nil || output.Credentials == nil {
+		return credentials_sdkv1.Value{}, fmt.Errorf("empty result")
+	}
+
+	// Refresh shortly before the session credentials expire.
+	p.SetExpiration(aws_sdkv1.TimeValue(output.Credentials.Expiration), 1*time.Minute)
+
+	return credentials_sdkv1.Value{
+		AccessKeyID:     aws_sdkv1.StringValue(output.Credentials.AccessKeyId),
+		ProviderName:    "S3ExpressSessionProvider",
+		SecretAccessKey: aws_sdkv1.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws_sdkv1.StringValue(output.Credentials.SessionToken),
+	}, nil
+}
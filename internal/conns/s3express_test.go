@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestS3ExpressAvailabilityZoneID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:          "empty",
+			input:         "",
+			expectedError: true,
+		},
+		{
+			name:          "general purpose bucket",
+			input:         "my-bucket",
+			expectedError: true,
+		},
+		{
+			name:          "no Availability Zone ID",
+			input:         "my-bucket--x-s3",
+			expectedError: true,
+		},
+		{
+			name:          "invalid Availability Zone ID",
+			input:         "my-bucket--usw2--x-s3",
+			expectedError: true,
+		},
+		{
+			name:          "uppercase base name",
+			input:         "My-Bucket--usw2-az1--x-s3",
+			expectedError: true,
+		},
+		{
+			name:     "directory bucket",
+			input:    "my-bucket--usw2-az1--x-s3",
+			expected: "usw2-az1",
+		},
+		{
+			name:     "directory bucket with double hyphen in base name",
+			input:    "my--bucket--use1-az4--x-s3",
+			expected: "use1-az4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := S3ExpressAvailabilityZoneID(testCase.input)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("got error: %t, expected: %t (%v)", got, want, err)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
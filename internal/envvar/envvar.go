@@ -84,6 +84,30 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweepers
+const (
+	// If set, resources are listed and not deleted
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// In dry-run mode, the file to which a JSON report of the resources that would be swept is written
+	SweepDryRunReport = "TF_AWS_SWEEP_DRY_RUN_REPORT"
+
+	// Resources created more recently than this duration ago, e.g. "24h", are not swept.
+	// Resources whose creation time is not known are not swept.
+	SweepMinimumAge = "TF_AWS_SWEEP_MINIMUM_AGE"
+
+	// Resources with this tag, in the form "key" or "key=value", are never swept
+	SweepProtectionTag = "TF_AWS_SWEEP_PROTECTION_TAG"
+
+	// A comma-separated list of tags in the form "key" or "key=value".
+	// If set, only resources with at least one of these tags are swept.
+	SweepTagFilters = "TF_AWS_SWEEP_TAG_FILTERS"
+
+	// The maximum number of resources deleted concurrently in each wave.
+	// Defaults to no limit.
+	SweepWaveConcurrency = "TF_AWS_SWEEP_WAVE_CONCURRENCY"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// RawConfigGetter is implemented by *schema.ResourceData.
+type RawConfigGetter interface {
+	GetRawConfigAt(cty.Path) (cty.Value, diag.Diagnostics)
+}
+
+// GetWriteOnlyValue returns the configured value of the write-only attribute at the specified path.
+// Write-only attribute values are never persisted to plan or state, so they can only be read from the raw resource configuration.
+func GetWriteOnlyValue(d RawConfigGetter, path cty.Path, valType cty.Type) (cty.Value, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return cty.NilVal, diags
+	}
+
+	if !v.Type().Equals(valType) {
+		return cty.NilVal, append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid write-only value type",
+			Detail:        "Expected " + valType.FriendlyName() + ", got " + v.Type().FriendlyName(),
+			AttributePath: path,
+		})
+	}
+
+	return v, diags
+}
+
+// GetWriteOnlyStringValue returns the configured value of the string write-only attribute at the specified path.
+// An empty string is returned if the attribute is not configured or its value is not yet known.
+// Write-only values are not persisted to state, so a change to the value can't be detected: resources pair each
+// write-only attribute with a version attribute and read the value in Update when the version changes.
+func GetWriteOnlyStringValue(d RawConfigGetter, path cty.Path) (string, diag.Diagnostics) {
+	v, diags := GetWriteOnlyValue(d, path, cty.String)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if v.IsNull() || !v.IsKnown() {
+		return "", diags
+	}
+
+	return v.AsString(), diags
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type mockRawConfigGetter struct {
+	config cty.Value
+}
+
+func (m mockRawConfigGetter) GetRawConfigAt(path cty.Path) (cty.Value, diag.Diagnostics) {
+	v, err := path.Apply(m.config)
+	if err != nil {
+		return cty.DynamicVal, diag.FromErr(err)
+	}
+
+	return v, nil
+}
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		config    cty.Value
+		path      cty.Path
+		expected  string
+		expectErr bool
+	}{
+		{
+			name: "configured",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.StringVal("s3cr3t"),
+			}),
+			path:     cty.GetAttrPath("password_wo"),
+			expected: "s3cr3t",
+		},
+		{
+			name: "null",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.NullVal(cty.String),
+			}),
+			path: cty.GetAttrPath("password_wo"),
+		},
+		{
+			name: "unknown",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.UnknownVal(cty.String),
+			}),
+			path: cty.GetAttrPath("password_wo"),
+		},
+		{
+			name: "wrong type",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.NumberIntVal(42),
+			}),
+			path:      cty.GetAttrPath("password_wo"),
+			expectErr: true,
+		},
+		{
+			name: "invalid path",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.StringVal("s3cr3t"),
+			}),
+			path:      cty.GetAttrPath("password"),
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := GetWriteOnlyStringValue(mockRawConfigGetter{config: testCase.config}, testCase.path)
+
+			if got, want := diags.HasError(), testCase.expectErr; got != want {
+				t.Fatalf("expected error %t, got %t: %v", want, got, diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
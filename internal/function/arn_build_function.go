@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
+)
+
+var _ function.Function = &arnBuildFunction{}
+
+func NewARNBuildFunction() function.Function {
+	return &arnBuildFunction{}
+}
+
+type arnBuildFunction struct{}
+
+func (f arnBuildFunction) Metadata(ctx context.Context, request function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "arn_build"
+}
+
+func (f arnBuildFunction) Definition(ctx context.Context, request function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "arn_build Function",
+		MarkdownDescription: "Builds an ARN from its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "partition",
+				MarkdownDescription: "Partition in which the resource is located",
+			},
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service namespace",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+			function.StringParameter{
+				Name:                "account_id",
+				MarkdownDescription: "AWS account identifier",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "Resource section, typically composed of a resource type and identifier",
+			},
+		},
+		Return: function.StringReturn{
+			CustomType: fwtypes.ARNType,
+		},
+	}
+}
+
+func (f arnBuildFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var partition, service, region, accountID, resource string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &partition, &service, &region, &accountID, &resource))
+	if response.Error != nil {
+		return
+	}
+
+	for i, v := range []string{partition, service, region, accountID, resource} {
+		switch i {
+		case 0, 1, 4:
+			// Partition, service and resource are always required.
+			if v == "" {
+				response.Error = function.ConcatFuncErrors(response.Error, function.NewArgumentFuncError(int64(i), "Value must not be empty."))
+				continue
+			}
+		}
+
+		if i == 0 {
+			if partitions := names.PartitionIDs(); !slices.Contains(partitions, v) {
+				response.Error = function.ConcatFuncErrors(response.Error, function.NewArgumentFuncError(int64(i), fmt.Sprintf("Value %q must be one of: %s.", v, strings.Join(partitions, ", "))))
+				continue
+			}
+		}
+
+		switch i {
+		case 0, 1, 2, 3:
+			// Every section other than the resource is delimited by a colon.
+			if strings.Contains(v, ":") {
+				response.Error = function.ConcatFuncErrors(response.Error, function.NewArgumentFuncError(int64(i), fmt.Sprintf("Value %q must not contain a colon.", v)))
+			}
+		}
+	}
+
+	if response.Error != nil {
+		return
+	}
+
+	result := fwtypes.ARNValue(arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resource,
+	})
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, result))
+}
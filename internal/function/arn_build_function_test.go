@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestARNBuildFunction_partitions(t *testing.T) {
+	t.Parallel()
+
+	for _, partition := range names.PartitionIDs() {
+		partition := partition
+		t.Run(partition, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(partition),
+					types.StringValue("iam"),
+					types.StringValue(""),
+					types.StringValue("123456789012"),
+					types.StringValue("role/path/to/role"),
+				}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(fwtypes.ARNUnknown()),
+			}
+
+			tffunction.NewARNBuildFunction().Run(ctx, request, &response)
+
+			if response.Error != nil {
+				t.Fatalf("unexpected error: %s", response.Error)
+			}
+
+			if got, want := response.Result.Value().String(), fmt.Sprintf("arn:%s:iam::123456789012:role/path/to/role", partition); got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestARNBuildFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		partition, service, region, accountID, resource string
+		expected                                        arn.ARN
+		expectedError                                   *function.FuncError
+	}{
+		"regional": {
+			partition: "aws",
+			service:   "rds",
+			region:    "us-east-1", // lintignore:AWSAT003
+			accountID: "123456789012",
+			resource:  "db:test",
+			expected: arn.ARN{
+				Partition: "aws",
+				Service:   "rds",
+				Region:    "us-east-1", // lintignore:AWSAT003
+				AccountID: "123456789012",
+				Resource:  "db:test",
+			},
+		},
+		"no region or account": {
+			partition: "aws",
+			service:   "s3",
+			resource:  "my-bucket/my-key",
+			expected: arn.ARN{
+				Partition: "aws",
+				Service:   "s3",
+				Resource:  "my-bucket/my-key",
+			},
+		},
+		"missing partition": {
+			service:       "s3",
+			resource:      "my-bucket",
+			expectedError: function.NewArgumentFuncError(0, "Value must not be empty."),
+		},
+		"unknown partition": {
+			partition:     "aws-unknown",
+			service:       "s3",
+			resource:      "my-bucket",
+			expectedError: function.NewArgumentFuncError(0, fmt.Sprintf(`Value "aws-unknown" must be one of: %s.`, strings.Join(names.PartitionIDs(), ", "))),
+		},
+		"missing service and resource": {
+			partition: "aws",
+			expectedError: function.ConcatFuncErrors(
+				function.NewArgumentFuncError(1, "Value must not be empty."),
+				function.NewArgumentFuncError(4, "Value must not be empty."),
+			),
+		},
+		"colon in region": {
+			partition:     "aws",
+			service:       "ec2",
+			region:        "us-east-1:", // lintignore:AWSAT003
+			resource:      "vpc/vpc-12345678",
+			expectedError: function.NewArgumentFuncError(2, `Value "us-east-1:" must not contain a colon.`), // lintignore:AWSAT003
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(test.partition),
+					types.StringValue(test.service),
+					types.StringValue(test.region),
+					types.StringValue(test.accountID),
+					types.StringValue(test.resource),
+				}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(fwtypes.ARNUnknown()),
+			}
+
+			tffunction.NewARNBuildFunction().Run(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Error, test.expectedError); diff != "" {
+				t.Errorf("unexpected error diff (+wanted, -got): %s", diff)
+			}
+
+			if test.expectedError != nil {
+				return
+			}
+
+			if expected := function.NewResultData(fwtypes.ARNValue(test.expected)); !response.Result.Equal(expected) {
+				t.Errorf("got %s, want %s", response.Result.Value(), expected.Value())
+			}
+		})
+	}
+}
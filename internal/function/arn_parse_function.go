@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var arnParseResultAttrTypes = map[string]attr.Type{
+	"partition":  types.StringType,
+	"service":    types.StringType,
+	"region":     types.StringType,
+	"account_id": types.StringType,
+	"resource":   types.StringType,
+}
+
+var _ function.Function = &arnParseFunction{}
+
+func NewARNParseFunction() function.Function {
+	return &arnParseFunction{}
+}
+
+type arnParseFunction struct{}
+
+func (f arnParseFunction) Metadata(ctx context.Context, request function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "arn_parse"
+}
+
+func (f arnParseFunction) Definition(ctx context.Context, request function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "arn_parse Function",
+		MarkdownDescription: "Parses an ARN into its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: arnParseResultAttrTypes,
+		},
+	}
+}
+
+func (f arnParseFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var arg types.String
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &arg))
+	if response.Error != nil {
+		return
+	}
+
+	v, diags := fwtypes.ARNType.ValueFromString(ctx, arg)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.NewArgumentFuncError(0, diags.Errors()[0].Detail()))
+		return
+	}
+
+	parts := v.(fwtypes.ARN).ValueARN()
+	result, diags := types.ObjectValue(arnParseResultAttrTypes, map[string]attr.Value{
+		"partition":  types.StringValue(parts.Partition),
+		"service":    types.StringValue(parts.Service),
+		"region":     types.StringValue(parts.Region),
+		"account_id": types.StringValue(parts.AccountID),
+		"resource":   types.StringValue(parts.Resource),
+	})
+
+	response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+	if response.Error != nil {
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, &result))
+}
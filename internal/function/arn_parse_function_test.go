@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var arnParseResultAttrTypes = map[string]attr.Type{
+	"partition":  types.StringType,
+	"service":    types.StringType,
+	"region":     types.StringType,
+	"account_id": types.StringType,
+	"resource":   types.StringType,
+}
+
+func TestARNParseFunction_partitions(t *testing.T) {
+	t.Parallel()
+
+	for _, partition := range names.PartitionIDs() {
+		partition := partition
+		t.Run(partition, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			arn := fmt.Sprintf("arn:%s:iam::123456789012:role/path/to/role", partition)
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(arn)}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(arnParseResultAttrTypes)),
+			}
+
+			tffunction.NewARNParseFunction().Run(ctx, request, &response)
+
+			if response.Error != nil {
+				t.Fatalf("unexpected error: %s", response.Error)
+			}
+
+			expected := function.NewResultData(types.ObjectValueMust(arnParseResultAttrTypes, map[string]attr.Value{
+				"partition":  types.StringValue(partition),
+				"service":    types.StringValue("iam"),
+				"region":     types.StringValue(""),
+				"account_id": types.StringValue("123456789012"),
+				"resource":   types.StringValue("role/path/to/role"),
+			}))
+
+			if !response.Result.Equal(expected) {
+				t.Errorf("got %s, want %s", response.Result.Value(), expected.Value())
+			}
+		})
+	}
+}
+
+func TestARNParseFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		arn           string
+		expected      map[string]attr.Value
+		expectedError *function.FuncError
+	}{
+		"regional": {
+			arn: "arn:aws:rds:us-east-1:123456789012:db:test", // lintignore:AWSAT003,AWSAT005
+			expected: map[string]attr.Value{
+				"partition":  types.StringValue("aws"),
+				"service":    types.StringValue("rds"),
+				"region":     types.StringValue("us-east-1"), // lintignore:AWSAT003
+				"account_id": types.StringValue("123456789012"),
+				"resource":   types.StringValue("db:test"),
+			},
+		},
+		"no account": {
+			arn: "arn:aws:s3:::my-bucket/my-key", // lintignore:AWSAT005
+			expected: map[string]attr.Value{
+				"partition":  types.StringValue("aws"),
+				"service":    types.StringValue("s3"),
+				"region":     types.StringValue(""),
+				"account_id": types.StringValue(""),
+				"resource":   types.StringValue("my-bucket/my-key"),
+			},
+		},
+		"invalid": {
+			arn:           "not an arn",
+			expectedError: function.NewArgumentFuncError(0, `String "not an arn" cannot be parsed as an ARN.`),
+		},
+		"too few sections": {
+			arn:           "arn:aws:s3",
+			expectedError: function.NewArgumentFuncError(0, `String "arn:aws:s3" cannot be parsed as an ARN.`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(test.arn)}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(arnParseResultAttrTypes)),
+			}
+
+			tffunction.NewARNParseFunction().Run(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Error, test.expectedError); diff != "" {
+				t.Errorf("unexpected error diff (+wanted, -got): %s", diff)
+			}
+
+			if test.expectedError != nil {
+				return
+			}
+
+			expected := function.NewResultData(types.ObjectValueMust(arnParseResultAttrTypes, test.expected))
+
+			if !response.Result.Equal(expected) {
+				t.Errorf("got %s, want %s", response.Result.Value(), expected.Value())
+			}
+		})
+	}
+}
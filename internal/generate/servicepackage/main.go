@@ -15,6 +15,7 @@ import (
 	"os"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -26,6 +27,7 @@ import (
 func main() {
 	const (
 		filename      = `service_package_gen.go`
+		testFilename  = `service_package_gen_test.go`
 		namesDataFile = `../../../names/names_data.csv`
 	)
 	g := common.NewGenerator()
@@ -60,11 +62,13 @@ func main() {
 			continue
 		}
 
-		// Look for Terraform Plugin Framework and SDK resource and data source annotations.
+		// Look for Terraform Plugin Framework and SDK resource and data source annotations,
+		// and for Terraform Plugin Framework ephemeral resource annotations.
 		// These annotations are implemented as comments on factory functions.
 		v := &visitor{
 			g: g,
 
+			ephemeralResources:   make([]ResourceDatum, 0),
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
@@ -83,10 +87,12 @@ func main() {
 			GoV2Package:          l[names.ColGoV2Package],
 			ProviderPackage:      p,
 			ProviderNameUpper:    l[names.ColProviderNameUpper],
+			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
 			SDKDataSources:       v.sdkDataSources,
 			SDKResources:         v.sdkResources,
+			GlobalResources:      p == "meta" || l[names.ColIsGlobal] != "",
 		}
 
 		if l[names.ColClientSDKV1] != "" {
@@ -101,6 +107,9 @@ func main() {
 			}
 		}
 
+		sort.SliceStable(s.EphemeralResources, func(i, j int) bool {
+			return s.EphemeralResources[i].FactoryName < s.EphemeralResources[j].FactoryName
+		})
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
@@ -118,6 +127,19 @@ func main() {
 			g.Fatalf("generating file (%s): %s", filename, err)
 		}
 
+		// Generate a resource identity conformance test for service packages with resource identities.
+		if s.HasResourceIdentity() {
+			d := g.NewGoFileDestination(testFilename)
+
+			if err := d.WriteTemplate("servicepackagetest", testTmpl, s); err != nil {
+				g.Fatalf("error generating %s service package test: %s", p, err)
+			}
+
+			if err := d.Write(); err != nil {
+				g.Fatalf("generating file (%s): %s", testFilename, err)
+			}
+		}
+
 		break
 	}
 }
@@ -128,6 +150,29 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	IdentityAttributes      []string // Natural key attributes, in import ID order
+	RegionOverrideDisabled  bool
+}
+
+// HasResourceIdentity returns whether the resource has a resource identity.
+// Every resource that supports transparent tagging has a resource identity.
+func (d ResourceDatum) HasResourceIdentity() bool {
+	return d.TransparentTagging || len(d.IdentityAttributes) > 0
+}
+
+// ResourceIdentityAttributes returns the resource identity's natural key attributes.
+// By default a resource's natural key is its ID.
+func (d ResourceDatum) ResourceIdentityAttributes() []string {
+	if len(d.IdentityAttributes) > 0 {
+		return d.IdentityAttributes
+	}
+
+	return []string{names.AttrID}
+}
+
+// IsIdentityAttribute returns whether the specified attribute is one of the resource identity's natural key attributes.
+func (d ResourceDatum) IsIdentityAttribute(name string) bool {
+	return slices.Contains(d.IdentityAttributes, name)
 }
 
 type ServiceDatum struct {
@@ -138,15 +183,37 @@ type ServiceDatum struct {
 	GoV2Package          string // AWS SDK for Go v2 package name
 	ProviderPackage      string
 	ProviderNameUpper    string
+	EphemeralResources   []ResourceDatum
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
+	GlobalResources      bool // Resources are global, so their identities do not include a Region and their Region cannot be overridden
+}
+
+// HasResourceIdentity returns whether any of the service package's resources has a resource identity.
+func (d ServiceDatum) HasResourceIdentity() bool {
+	for _, v := range d.FrameworkResources {
+		if v.HasResourceIdentity() {
+			return true
+		}
+	}
+
+	for _, v := range d.SDKResources {
+		if v.HasResourceIdentity() {
+			return true
+		}
+	}
+
+	return false
 }
 
 //go:embed file.tmpl
 var tmpl string
 
+//go:embed test.tmpl
+var testTmpl string
+
 // Annotation processing.
 var (
 	annotation = regexp.MustCompile(`^//\s*@([a-zA-Z0-9]+)(\(([^)]*)\))?\s*$`)
@@ -160,6 +227,7 @@ type visitor struct {
 	functionName string
 	packageName  string
 
+	ephemeralResources   []ResourceDatum
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
@@ -201,7 +269,8 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source,
+// or a Plugin Framework ephemeral resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
@@ -230,6 +299,37 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
+	// Then look for resource identity and Region annotations.
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			switch m[1] {
+			case "IdentityAttribute":
+				args := common.ParseArgs(m[3])
+
+				if len(args.Positional) == 0 {
+					v.err = multierror.Append(v.err, fmt.Errorf("no IdentityAttribute attribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				d.IdentityAttributes = append(d.IdentityAttributes, args.Positional[0])
+			case "Region":
+				args := common.ParseArgs(m[3])
+
+				if attr, ok := args.Keyword["overrideEnabled"]; ok {
+					enabled, err := strconv.ParseBool(attr)
+					if err != nil {
+						v.err = multierror.Append(v.err, fmt.Errorf("invalid Region overrideEnabled value (%s): %s", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					}
+
+					d.RegionOverrideDisabled = !enabled
+				}
+			}
+		}
+	}
+
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
@@ -243,6 +343,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			}
 
 			switch annotationName := m[1]; annotationName {
+			case "EphemeralResource":
+				if slices.ContainsFunc(v.ephemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.ephemeralResources = append(v.ephemeralResources, d)
+				}
 			case "FrameworkDataSource":
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -281,7 +387,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "IdentityAttribute", "Region", "Tags":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/auditlog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// auditLogInterceptor attributes the mutating AWS API calls made by a resource's CRUD handler to the resource.
+type auditLogInterceptor struct {
+	typeName string
+}
+
+func (r auditLogInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	client, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	logger := client.AuditLogger()
+	if logger == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = auditlog.NewContext(ctx, &auditlog.Invocation{
+			Action:       whyName(why),
+			ResourceID:   d.Id(),
+			ResourceType: r.typeName,
+		})
+	case Finally:
+		inv, ok := auditlog.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		// The resource's ID is only known after Create.
+		if inv.ResourceID == "" {
+			inv.ResourceID = d.Id()
+		}
+
+		if err := logger.Flush(inv); err != nil {
+			tflog.Warn(ctx, "writing audit log", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return ctx, diags
+}
+
+func whyName(why why) string {
+	switch why {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return ""
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -22,3 +24,32 @@ func BenchmarkProtoV5ProviderServerFactory(b *testing.B) {
 		b.Logf("%d resources, %d data sources", len(p.ResourcesMap), len(p.DataSourcesMap))
 	}
 }
+
+func TestProtoV5ProviderServerFactory_functions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	factory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := factory().GetFunctions(ctx, &tfprotov5.GetFunctionsRequest{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", v.Summary, v.Detail)
+		}
+	}
+
+	for _, name := range []string{"arn_build", "arn_parse"} {
+		if _, ok := response.Functions[name]; !ok {
+			t.Errorf("function %q not found", name)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/auditlog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// auditLogInterceptor attributes the mutating AWS API calls made by a resource's CRUD handler to the resource.
+type auditLogInterceptor struct {
+	typeName string
+}
+
+func (r auditLogInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = r.newContext(ctx, meta, "Create", "")
+	case Finally:
+		r.flush(ctx, meta, response.State)
+	}
+
+	return ctx, diags
+}
+
+func (r auditLogInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r auditLogInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = r.newContext(ctx, meta, "Update", resourceID(ctx, request.State))
+	case Finally:
+		r.flush(ctx, meta, response.State)
+	}
+
+	return ctx, diags
+}
+
+func (r auditLogInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = r.newContext(ctx, meta, "Delete", resourceID(ctx, request.State))
+	case Finally:
+		r.flush(ctx, meta, request.State)
+	}
+
+	return ctx, diags
+}
+
+func (r auditLogInterceptor) newContext(ctx context.Context, meta *conns.AWSClient, action, id string) context.Context {
+	if meta == nil || meta.AuditLogger() == nil {
+		return ctx
+	}
+
+	return auditlog.NewContext(ctx, &auditlog.Invocation{
+		Action:       action,
+		ResourceID:   id,
+		ResourceType: r.typeName,
+	})
+}
+
+func (r auditLogInterceptor) flush(ctx context.Context, meta *conns.AWSClient, state tfsdk.State) {
+	if meta == nil || meta.AuditLogger() == nil {
+		return
+	}
+
+	inv, ok := auditlog.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	// The resource's ID is only known after Create.
+	if inv.ResourceID == "" {
+		inv.ResourceID = resourceID(ctx, state)
+	}
+
+	if err := meta.AuditLogger().Flush(inv); err != nil {
+		tflog.Warn(ctx, "writing audit log", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+// resourceID returns the value of the resource's `id` attribute, if any.
+func resourceID(ctx context.Context, state tfsdk.State) string {
+	if state.Raw.IsNull() {
+		return ""
+	}
+
+	var id fwtypes.String
+	if diags := state.GetAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() {
+		return ""
+	}
+
+	return id.ValueString()
+}
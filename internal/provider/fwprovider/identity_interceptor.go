@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newIdentitySchema returns the Plugin Framework identity schema for the specified resource identity.
+func newIdentitySchema(v *types.ServicePackageResourceIdentity) identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(v.Attributes))
+
+	for _, attribute := range v.Attributes {
+		attributes[attribute.Name] = identityschema.StringAttribute{
+			RequiredForImport: attribute.Required,
+			OptionalForImport: !attribute.Required,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource with a resource identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = newIdentitySchema(w.identity)
+}
+
+func (w *wrappedResourceWithIdentity) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// If the resource is being imported by identity rather than by ID, set the import ID from the identity.
+	if request.ID == "" && request.Identity != nil {
+		id, diags := importIDFromIdentity(ctx, request.Identity, w.identity, w.regionOverrideEnabled, w.meta)
+
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		request.ID = id
+	}
+
+	w.wrappedResource.ImportState(ctx, request, response)
+}
+
+// importIDFromIdentity returns the import ID corresponding to the resource's identity.
+// The values of the identity's required attributes are joined using the standard resource ID separator.
+// If the resource's Region can be overridden, an identity Region that differs from the provider's is appended as `@<region>`.
+func importIDFromIdentity(ctx context.Context, v *tfsdk.ResourceIdentity, identity *types.ServicePackageResourceIdentity, regionOverrideEnabled bool, meta *conns.AWSClient) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var parts []string
+	var region string
+
+	for _, attribute := range identity.Attributes {
+		var s basetypes.StringValue
+		diags.Append(v.GetAttribute(ctx, path.Root(attribute.Name), &s)...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		switch {
+		case attribute.Required:
+			if s.ValueString() == "" {
+				diags.AddAttributeError(path.Root(attribute.Name), "Invalid Import Identity", fmt.Sprintf("Resource identity attribute (%s) is required.", attribute.Name))
+				return "", diags
+			}
+
+			parts = append(parts, s.ValueString())
+		case attribute.Name == names.AttrAccountID:
+			if s := s.ValueString(); s != "" && meta != nil && s != meta.AccountID {
+				diags.AddAttributeError(path.Root(attribute.Name), "Invalid Import Identity", fmt.Sprintf("Resource identity account ID (%s) does not match provider account ID (%s).", s, meta.AccountID))
+				return "", diags
+			}
+		case attribute.Name == names.AttrRegion:
+			if s := s.ValueString(); s != "" && meta != nil && s != meta.Region {
+				if !regionOverrideEnabled {
+					diags.AddAttributeError(path.Root(attribute.Name), "Invalid Import Identity", fmt.Sprintf("Resource identity Region (%s) does not match provider Region (%s).", s, meta.Region))
+					return "", diags
+				}
+
+				region = s
+			}
+		}
+	}
+
+	id := strings.Join(parts, flex.ResourceIdSeparator)
+	if region != "" {
+		id += "@" + region
+	}
+
+	return id, diags
+}
+
+// identityInterceptor sets the resource's identity after Create, Read and Update.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// setIdentity sets the resource identity's attribute values from the resource's state and the provider configuration.
+func (r identityInterceptor) setIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.identity == nil || identity == nil || meta == nil {
+		return diags
+	}
+
+	for _, attribute := range r.identity.Attributes {
+		var s string
+
+		switch {
+		case !attribute.Required && attribute.Name == names.AttrAccountID:
+			s = meta.AccountID
+		case !attribute.Required && attribute.Name == names.AttrRegion:
+			s = meta.Region
+		default:
+			var value attr.Value
+			diags.Append(state.GetAttribute(ctx, path.Root(attribute.Name), &value)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if value, ok := value.(basetypes.StringValuable); ok {
+				v, d := value.ToStringValue(ctx)
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				s = v.ValueString()
+			}
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(attribute.Name), s)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// ValidateResourceIdentity checks that the resource identity's natural key attributes are defined in the resource's schema.
+func ValidateResourceIdentity(ctx context.Context, identity *types.ServicePackageResourceIdentity, r resource.Resource) error {
+	if len(identity.Attributes) == 0 {
+		return fmt.Errorf("resource identity has no attributes")
+	}
+
+	schemaResponse := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	for _, v := range identity.Attributes {
+		if !v.Required {
+			continue
+		}
+
+		attribute, ok := schemaResponse.Schema.Attributes[v.Name]
+		if !ok {
+			return fmt.Errorf("resource identity attribute (%s) not defined in schema", v.Name)
+		}
+		if _, ok := attribute.GetType().(basetypes.StringTypable); !ok {
+			return fmt.Errorf("resource identity attribute (%s) must be of type string", v.Name)
+		}
+		if attribute.IsSensitive() || attribute.IsWriteOnly() {
+			return fmt.Errorf("resource identity attribute (%s) cannot be Sensitive or WriteOnly", v.Name)
+		}
+	}
+
+	return nil
+}
@@ -6,9 +6,11 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
@@ -103,6 +105,11 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 			}
 		}
 
+		// All other interceptors are passed a client for the resource's effective Region.
+		if meta != nil {
+			meta = meta.ForRegion(meta.EffectiveRegion(ctx))
+		}
+
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
 		diags = f(ctx, request, response)
@@ -131,15 +138,17 @@ type contextFunc func(context.Context, *conns.AWSClient) context.Context
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext contextFunc
-	inner            datasource.DataSourceWithConfigure
-	meta             *conns.AWSClient
+	bootstrapContext      contextFunc
+	inner                 datasource.DataSourceWithConfigure
+	meta                  *conns.AWSClient
+	regionOverrideEnabled bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, region *types.ServicePackageResourceRegion) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
+		bootstrapContext:      bootstrapContext,
+		inner:                 inner,
+		regionOverrideEnabled: region != nil && region.IsOverrideEnabled,
 	}
 }
 
@@ -151,33 +160,259 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverrideEnabled {
+		w.addRegionToSchema(response)
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.regionOverrideEnabled {
+		w.readWithRegion(ctx, request, response)
+
+		return
+	}
+
 	w.inner.Read(ctx, request, response)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
-// wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
-type wrappedResource struct {
+// wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
+type wrappedEphemeralResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
+	inner            ephemeral.EphemeralResourceWithConfigure
 	meta             *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	w := &wrappedEphemeralResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
-		interceptors:     interceptors,
 	}
+
+	// Only advertise the optional capabilities that the inner ephemeral resource implements.
+	// The anonymous struct types below get the methods of their embedded fields promoted.
+	var capabilities int
+	const (
+		withRenew = 1 << iota
+		withClose
+		withConfigValidators
+		withValidateConfig
+	)
+	if _, ok := inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		capabilities |= withRenew
+	}
+	if _, ok := inner.(ephemeral.EphemeralResourceWithClose); ok {
+		capabilities |= withClose
+	}
+	if _, ok := inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		capabilities |= withConfigValidators
+	}
+	if _, ok := inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		capabilities |= withValidateConfig
+	}
+
+	r, c, cv, vc := ephemeralResourceRenewer{w}, ephemeralResourceCloser{w}, ephemeralResourceConfigValidatorsProvider{w}, ephemeralResourceConfigValidator{w}
+
+	switch capabilities {
+	case withRenew:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceRenewer
+		}{w, r}
+	case withClose:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceCloser
+		}{w, c}
+	case withRenew | withClose:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceRenewer
+			ephemeralResourceCloser
+		}{w, r, c}
+	case withConfigValidators:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceConfigValidatorsProvider
+		}{w, cv}
+	case withRenew | withConfigValidators:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceRenewer
+			ephemeralResourceConfigValidatorsProvider
+		}{w, r, cv}
+	case withClose | withConfigValidators:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceCloser
+			ephemeralResourceConfigValidatorsProvider
+		}{w, c, cv}
+	case withRenew | withClose | withConfigValidators:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceRenewer
+			ephemeralResourceCloser
+			ephemeralResourceConfigValidatorsProvider
+		}{w, r, c, cv}
+	case withValidateConfig:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceConfigValidator
+		}{w, vc}
+	case withRenew | withValidateConfig:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceRenewer
+			ephemeralResourceConfigValidator
+		}{w, r, vc}
+	case withClose | withValidateConfig:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceCloser
+			ephemeralResourceConfigValidator
+		}{w, c, vc}
+	case withRenew | withClose | withValidateConfig:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceRenewer
+			ephemeralResourceCloser
+			ephemeralResourceConfigValidator
+		}{w, r, c, vc}
+	case withConfigValidators | withValidateConfig:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceConfigValidatorsProvider
+			ephemeralResourceConfigValidator
+		}{w, cv, vc}
+	case withRenew | withConfigValidators | withValidateConfig:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceRenewer
+			ephemeralResourceConfigValidatorsProvider
+			ephemeralResourceConfigValidator
+		}{w, r, cv, vc}
+	case withClose | withConfigValidators | withValidateConfig:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceCloser
+			ephemeralResourceConfigValidatorsProvider
+			ephemeralResourceConfigValidator
+		}{w, c, cv, vc}
+	case withRenew | withClose | withConfigValidators | withValidateConfig:
+		return struct {
+			*wrappedEphemeralResource
+			ephemeralResourceRenewer
+			ephemeralResourceCloser
+			ephemeralResourceConfigValidatorsProvider
+			ephemeralResourceConfigValidator
+		}{w, r, c, cv, vc}
+	}
+
+	return w
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+// ephemeralResourceRenewer forwards Renew to a wrapped ephemeral resource that implements it.
+type ephemeralResourceRenewer struct {
+	w *wrappedEphemeralResource
+}
+
+func (r ephemeralResourceRenewer) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	ctx = r.w.bootstrapContext(ctx, r.w.meta)
+	r.w.inner.(ephemeral.EphemeralResourceWithRenew).Renew(ctx, request, response)
+}
+
+// ephemeralResourceCloser forwards Close to a wrapped ephemeral resource that implements it.
+type ephemeralResourceCloser struct {
+	w *wrappedEphemeralResource
+}
+
+func (c ephemeralResourceCloser) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	ctx = c.w.bootstrapContext(ctx, c.w.meta)
+	c.w.inner.(ephemeral.EphemeralResourceWithClose).Close(ctx, request, response)
+}
+
+// ephemeralResourceConfigValidatorsProvider forwards ConfigValidators to a wrapped ephemeral resource that implements it.
+type ephemeralResourceConfigValidatorsProvider struct {
+	w *wrappedEphemeralResource
+}
+
+func (v ephemeralResourceConfigValidatorsProvider) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	ctx = v.w.bootstrapContext(ctx, v.w.meta)
+	return v.w.inner.(ephemeral.EphemeralResourceWithConfigValidators).ConfigValidators(ctx)
+}
+
+// ephemeralResourceConfigValidator forwards ValidateConfig to a wrapped ephemeral resource that implements it.
+type ephemeralResourceConfigValidator struct {
+	w *wrappedEphemeralResource
+}
+
+func (v ephemeralResourceConfigValidator) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	ctx = v.w.bootstrapContext(ctx, v.w.meta)
+	v.w.inner.(ephemeral.EphemeralResourceWithValidateConfig).ValidateConfig(ctx, request, response)
+}
+
+// wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
+type wrappedResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext      contextFunc
+	inner                 resource.ResourceWithConfigure
+	interceptors          resourceInterceptors
+	meta                  *conns.AWSClient
+	regionOverrideEnabled bool
+	regionValues          regionValues
+	regionValuesOnce      sync.Once
+}
+
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, identity *types.ServicePackageResourceIdentity, region *types.ServicePackageResourceRegion) resource.ResourceWithConfigure {
+	w := &wrappedResource{
+		bootstrapContext:      bootstrapContext,
+		inner:                 inner,
+		interceptors:          interceptors,
+		regionOverrideEnabled: region != nil && region.IsOverrideEnabled,
+	}
+
+	if identity != nil {
+		return &wrappedResourceWithIdentity{
+			wrappedResource: w,
+			identity:        identity,
+		}
+	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -188,11 +423,19 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverrideEnabled {
+		w.addRegionToSchema(response)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		if w.regionOverrideEnabled {
+			w.createWithRegion(ctx, request, response)
+		} else {
+			w.inner.Create(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -202,7 +445,11 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if w.regionOverrideEnabled {
+			w.readWithRegion(ctx, request, response)
+		} else {
+			w.inner.Read(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -212,7 +459,11 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		if w.regionOverrideEnabled {
+			w.updateWithRegion(ctx, request, response)
+		} else {
+			w.inner.Update(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -222,7 +473,11 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		if w.regionOverrideEnabled {
+			w.deleteWithRegion(ctx, request, response)
+		} else {
+			w.inner.Delete(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -241,7 +496,12 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+
+		if w.regionOverrideEnabled {
+			w.importStateWithRegion(ctx, v, request, response)
+		} else {
+			v.ImportState(ctx, request, response)
+		}
 
 		return
 	}
@@ -253,6 +513,13 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if w.regionOverrideEnabled {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		w.modifyPlanWithRegion(ctx, request, response)
+
+		return
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
@@ -273,7 +540,12 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ValidateConfig(ctx, request, response)
+
+		if w.regionOverrideEnabled {
+			w.validateConfigWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ValidateConfig(ctx, request, response)
+		}
 	}
 }
 
@@ -281,6 +553,10 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
+		if w.regionOverrideEnabled {
+			return w.upgradeStateWithoutRegion(ctx, v.UpgradeState(ctx))
+		}
+
 		return v.UpgradeState(ctx)
 	}
 
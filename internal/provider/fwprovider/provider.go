@@ -10,6 +10,8 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -30,6 +33,11 @@ func New(primary interface{ Meta() interface{} }) provider.Provider {
 	}
 }
 
+var (
+	_ provider.ProviderWithEphemeralResources = &fwprovider{}
+	_ provider.ProviderWithFunctions          = &fwprovider{}
+)
+
 type fwprovider struct {
 	Primary interface{ Meta() interface{} }
 }
@@ -229,6 +237,20 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"audit_log": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for the audit log of mutating AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Required:    true,
+							Description: "File to which the audit log is appended, one JSON object per line.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -265,6 +287,43 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"mock": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for recording and replaying AWS API interactions.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cassette_dir": schema.StringAttribute{
+							Required:    true,
+							Description: "Directory to which AWS API interactions are recorded and from which they are replayed.",
+						},
+						"mode": schema.StringAttribute{
+							Required:    true,
+							Description: "Whether to record interactions with AWS or to replay recorded interactions. Valid values are `record` and `replay`.",
+						},
+					},
+				},
+			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Configuration block with settings for client-side rate limiting of AWS API requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be sent at once. Defaults to `requests_per_second` rounded up.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The sustained number of AWS API requests per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to rate limit, e.g. `ec2`. Valid values are the service names used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -276,6 +335,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
+	response.EphemeralResourceData = v
 	response.ResourceData = v
 }
 
@@ -314,7 +374,13 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner)
+				// Each wrapped data source has its own inner data source, which may be configured with a client for the data source's Region.
+				inner := inner
+				if d, err := v.Factory(ctx); err == nil {
+					inner = d
+				}
+
+				return newWrappedDataSource(bootstrapContext, inner, v.Region)
 			})
 		}
 	}
@@ -358,6 +424,22 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			if v.Region != nil && v.Region.IsOverrideEnabled {
+				// The resource's Region can be overridden.
+				// Ensure that the schema does not already define a top-level `region` attribute.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = multierror.Append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, regionInterceptor{})
+			}
+
+			interceptors = append(interceptors, auditLogInterceptor{typeName: typeName})
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -386,8 +468,25 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsInterceptor{tags: v.Tags})
 			}
 
+			if v.Identity != nil {
+				// The resource has a resource identity.
+				// Ensure that the natural key attributes are defined in the schema.
+				if err := ValidateResourceIdentity(ctx, v.Identity, inner); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("%s: %w", typeName, err))
+					continue
+				}
+
+				interceptors = append(interceptors, identityInterceptor{identity: v.Identity})
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				// Each wrapped resource has its own inner resource, which may be configured with a client for the resource's Region.
+				inner := inner
+				if r, err := v.Factory(ctx); err == nil {
+					inner = r
+				}
+
+				return newWrappedResource(bootstrapContext, inner, interceptors, v.Identity, v.Region)
 			})
 		}
 	}
@@ -401,6 +500,70 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		sp, ok := sp.(conns.ServicePackageWithEphemeralResources)
+
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.EphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				tflog.Warn(ctx, "creating ephemeral resource", map[string]interface{}{
+					"service_package_name": n,
+					"error":                err.Error(),
+				})
+
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				// Each wrapped ephemeral resource has its own inner ephemeral resource, which is configured and opened independently.
+				inner := inner
+				if r, err := v.Factory(ctx); err == nil {
+					inner = r
+				}
+
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	return ephemeralResources
+}
+
+// Functions returns a slice of functions to instantiate each Function
+// implementation.
+//
+// The function type name is determined by the Function implementing
+// the Metadata method. All functions must have unique names.
+func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		tffunction.NewARNBuildFunction,
+		tffunction.NewARNParseFunction,
+	}
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -0,0 +1,570 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The top-level `region` argument is added to the schema of resources and data sources whose Region can be overridden.
+// The argument is handled entirely by the wrapper and is hidden from the inner resource or data source,
+// whose models and schemas therefore do not need to change.
+
+// regionResourceAttribute returns the schema of the top-level `region` argument added to resources whose Region can be overridden.
+func regionResourceAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+		Validators: []validator.String{
+			fwvalidators.RegionName(),
+		},
+	}
+}
+
+// regionDataSourceAttribute returns the schema of the top-level `region` argument added to data sources whose Region can be overridden.
+func regionDataSourceAttribute() dsschema.Attribute {
+	return dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which the data source is read. Defaults to the Region set in the provider configuration.",
+		Validators: []validator.String{
+			fwvalidators.RegionName(),
+		},
+	}
+}
+
+// withoutRegion returns the specified object value without its top-level `region` attribute, and the attribute's value.
+func withoutRegion(typ tftypes.Type, v tftypes.Value) (tftypes.Value, tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), tftypes.NewValue(tftypes.String, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return tftypes.Value{}, tftypes.Value{}, err
+	}
+
+	region, ok := m[names.AttrRegion]
+	if !ok {
+		region = tftypes.NewValue(tftypes.String, nil)
+	}
+	delete(m, names.AttrRegion)
+
+	return tftypes.NewValue(typ, m), region, nil
+}
+
+// withRegion returns the specified object value with the specified top-level `region` attribute value.
+func withRegion(typ tftypes.Type, v tftypes.Value, region tftypes.Value) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	m[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, m), nil
+}
+
+// regionOrDefault returns the specified `region` attribute value if it's set, otherwise the effective Region.
+func regionOrDefault(ctx context.Context, meta *conns.AWSClient, region tftypes.Value) tftypes.Value {
+	if region.IsKnown() && !region.IsNull() {
+		var s string
+		if err := region.As(&s); err == nil && s != "" {
+			return region
+		}
+	}
+
+	if meta == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, meta.EffectiveRegion(ctx))
+}
+
+func setOverrideRegion(ctx context.Context, region string) {
+	if region == "" {
+		return
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region
+	}
+}
+
+// regionInterceptor implements per-resource Region override.
+// Before each CRUD operation the resource's Region is set as the Region override in Context.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(r.setOverrideRegion(ctx, request.Plan.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(r.setOverrideRegion(ctx, request.State.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(r.setOverrideRegion(ctx, request.Plan.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(r.setOverrideRegion(ctx, request.State.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) setOverrideRegion(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) diag.Diagnostics {
+	var region fwtypes.String
+	diags := getAttribute(ctx, path.Root(names.AttrRegion), &region)
+	if diags.HasError() {
+		return diags
+	}
+
+	setOverrideRegion(ctx, region.ValueString())
+
+	return diags
+}
+
+// innerSchema returns the inner resource's schema, which does not include the top-level `region` argument.
+func (w *wrappedResource) innerSchema(ctx context.Context) (schema.Schema, diag.Diagnostics) {
+	response := resource.SchemaResponse{}
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	return response.Schema, response.Diagnostics
+}
+
+// configureInnerForRegion configures the inner resource with a client for the resource's effective Region,
+// so that the inner resource's own use of its client (e.g. its Region when building ARNs) honors any Region override.
+func (w *wrappedResource) configureInnerForRegion(ctx context.Context) diag.Diagnostics {
+	response := resource.ConfigureResponse{}
+
+	if w.meta != nil {
+		w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: w.meta.ForRegion(w.meta.EffectiveRegion(ctx))}, &response)
+	}
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) addRegionToSchema(response *resource.SchemaResponse) {
+	if _, ok := response.Schema.Attributes[names.AttrRegion]; ok {
+		response.Diagnostics.AddError("Invalid Resource Schema", fmt.Sprintf("`%s` attribute already defined in schema.", names.AttrRegion))
+		return
+	}
+
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]schema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = regionResourceAttribute()
+}
+
+// regionValues converts values between the wrapped and inner resources' schemas.
+type regionValues struct {
+	innerSchema schema.Schema
+	innerType   tftypes.Type
+	diags       diag.Diagnostics
+}
+
+// newRegionValues returns a value converter for the wrapped resource.
+// The inner resource's schema is computed once per wrapped resource.
+func (w *wrappedResource) newRegionValues(ctx context.Context) *regionValues {
+	w.regionValuesOnce.Do(func() {
+		s, diags := w.innerSchema(ctx)
+
+		w.regionValues = regionValues{
+			innerSchema: s,
+			innerType:   s.Type().TerraformType(ctx),
+			diags:       diags,
+		}
+	})
+
+	v := w.regionValues
+	// Diagnostics added by the caller must not be added to the cached diagnostics.
+	v.diags = v.diags[:len(v.diags):len(v.diags)]
+
+	return &v
+}
+
+func (v *regionValues) without(raw tftypes.Value) (tftypes.Value, tftypes.Value) {
+	inner, region, err := withoutRegion(v.innerType, raw)
+	if err != nil {
+		v.diags.AddError("Removing Region", err.Error())
+	}
+
+	return inner, region
+}
+
+func (v *regionValues) config(config tfsdk.Config) tfsdk.Config {
+	raw, _ := v.without(config.Raw)
+
+	return tfsdk.Config{Schema: v.innerSchema, Raw: raw}
+}
+
+func (v *regionValues) plan(plan tfsdk.Plan) (tfsdk.Plan, tftypes.Value) {
+	raw, region := v.without(plan.Raw)
+
+	return tfsdk.Plan{Schema: v.innerSchema, Raw: raw}, region
+}
+
+func (v *regionValues) state(state tfsdk.State) (tfsdk.State, tftypes.Value) {
+	raw, region := v.without(state.Raw)
+
+	return tfsdk.State{Schema: v.innerSchema, Raw: raw}, region
+}
+
+func (v *regionValues) with(typ tftypes.Type, raw tftypes.Value, region tftypes.Value) tftypes.Value {
+	raw, err := withRegion(typ, raw, region)
+	if err != nil {
+		v.diags.AddError("Adding Region", err.Error())
+	}
+
+	return raw
+}
+
+func (w *wrappedResource) createWithRegion(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	v := w.newRegionValues(ctx)
+	state, typ := response.State, response.State.Schema.Type().TerraformType(ctx)
+
+	innerRequest := request
+	innerRequest.Config = v.config(request.Config)
+	var region tftypes.Value
+	innerRequest.Plan, region = v.plan(request.Plan)
+	innerResponse := *response
+	innerResponse.State, _ = v.state(response.State)
+	if response.Diagnostics.Append(v.diags...); response.Diagnostics.HasError() {
+		return
+	}
+	if response.Diagnostics.Append(w.configureInnerForRegion(ctx)...); response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Create(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Schema: state.Schema, Raw: v.with(typ, innerResponse.State.Raw, regionOrDefault(ctx, w.meta, region))}
+	response.Diagnostics.Append(v.diags...)
+}
+
+func (w *wrappedResource) readWithRegion(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	v := w.newRegionValues(ctx)
+	state, typ := response.State, response.State.Schema.Type().TerraformType(ctx)
+
+	innerRequest := request
+	var region tftypes.Value
+	innerRequest.State, region = v.state(request.State)
+	innerResponse := *response
+	innerResponse.State, _ = v.state(response.State)
+	if response.Diagnostics.Append(v.diags...); response.Diagnostics.HasError() {
+		return
+	}
+	if response.Diagnostics.Append(w.configureInnerForRegion(ctx)...); response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Schema: state.Schema, Raw: v.with(typ, innerResponse.State.Raw, regionOrDefault(ctx, w.meta, region))}
+	response.Diagnostics.Append(v.diags...)
+}
+
+func (w *wrappedResource) updateWithRegion(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	v := w.newRegionValues(ctx)
+	state, typ := response.State, response.State.Schema.Type().TerraformType(ctx)
+
+	innerRequest := request
+	innerRequest.Config = v.config(request.Config)
+	var region tftypes.Value
+	innerRequest.Plan, region = v.plan(request.Plan)
+	innerRequest.State, _ = v.state(request.State)
+	innerResponse := *response
+	innerResponse.State, _ = v.state(response.State)
+	if response.Diagnostics.Append(v.diags...); response.Diagnostics.HasError() {
+		return
+	}
+	if response.Diagnostics.Append(w.configureInnerForRegion(ctx)...); response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Update(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Schema: state.Schema, Raw: v.with(typ, innerResponse.State.Raw, regionOrDefault(ctx, w.meta, region))}
+	response.Diagnostics.Append(v.diags...)
+}
+
+func (w *wrappedResource) deleteWithRegion(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	v := w.newRegionValues(ctx)
+	state, typ := response.State, response.State.Schema.Type().TerraformType(ctx)
+
+	innerRequest := request
+	innerRequest.State, _ = v.state(request.State)
+	innerResponse := *response
+	var region tftypes.Value
+	innerResponse.State, region = v.state(response.State)
+	if response.Diagnostics.Append(v.diags...); response.Diagnostics.HasError() {
+		return
+	}
+	if response.Diagnostics.Append(w.configureInnerForRegion(ctx)...); response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Schema: state.Schema, Raw: v.with(typ, innerResponse.State.Raw, region)}
+	response.Diagnostics.Append(v.diags...)
+}
+
+func (w *wrappedResource) importStateWithRegion(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var region tftypes.Value
+	if id, v, ok := verify.CutImportIDRegion(request.ID); ok {
+		request.ID = id
+		region = tftypes.NewValue(tftypes.String, v)
+		setOverrideRegion(ctx, v)
+	} else {
+		region = tftypes.NewValue(tftypes.String, nil)
+	}
+
+	v := w.newRegionValues(ctx)
+	state, typ := response.State, response.State.Schema.Type().TerraformType(ctx)
+
+	innerResponse := *response
+	innerResponse.State, _ = v.state(response.State)
+	if response.Diagnostics.Append(v.diags...); response.Diagnostics.HasError() {
+		return
+	}
+	if response.Diagnostics.Append(w.configureInnerForRegion(ctx)...); response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ImportState(ctx, request, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Schema: state.Schema, Raw: v.with(typ, innerResponse.State.Raw, regionOrDefault(ctx, w.meta, region))}
+	response.Diagnostics.Append(v.diags...)
+}
+
+// modifyPlanWithRegion defaults the resource's Region to the provider's configured Region.
+// A change in the resource's Region forces the resource to be replaced.
+func (w *wrappedResource) modifyPlanWithRegion(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy plan.
+	if request.Plan.Raw.IsNull() {
+		w.modifyPlanWithoutRegion(ctx, request, response)
+		return
+	}
+
+	var configRegion, planRegion fwtypes.String
+	if response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...); response.Diagnostics.HasError() {
+		return
+	}
+	if response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &planRegion)...); response.Diagnostics.HasError() {
+		return
+	}
+
+	if configRegion.IsNull() && w.meta != nil {
+		planRegion = fwtypes.StringValue(w.meta.Region)
+		if response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), planRegion)...); response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Existing resources whose state predates Region override have their Region set on the next refresh.
+	if !request.State.Raw.IsNull() {
+		var stateRegion fwtypes.String
+		if response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...); response.Diagnostics.HasError() {
+			return
+		}
+
+		if stateRegion.ValueString() != "" && !planRegion.IsUnknown() && !planRegion.Equal(stateRegion) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	setOverrideRegion(ctx, planRegion.ValueString())
+
+	w.modifyPlanWithoutRegion(ctx, request, response)
+}
+
+// modifyPlanWithoutRegion calls the inner resource's ModifyPlan, hiding the top-level `region` attribute from it.
+func (w *wrappedResource) modifyPlanWithoutRegion(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	inner, ok := w.inner.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return
+	}
+
+	v := w.newRegionValues(ctx)
+	plan, typ := response.Plan, response.Plan.Schema.Type().TerraformType(ctx)
+
+	innerRequest := request
+	innerRequest.Config = v.config(request.Config)
+	innerRequest.Plan, _ = v.plan(request.Plan)
+	innerRequest.State, _ = v.state(request.State)
+	innerResponse := *response
+	var region tftypes.Value
+	innerResponse.Plan, region = v.plan(response.Plan)
+	if response.Diagnostics.Append(v.diags...); response.Diagnostics.HasError() {
+		return
+	}
+	if response.Diagnostics.Append(w.configureInnerForRegion(ctx)...); response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.Plan = tfsdk.Plan{Schema: plan.Schema, Raw: v.with(typ, innerResponse.Plan.Raw, region)}
+	response.Diagnostics.Append(v.diags...)
+}
+
+func (w *wrappedResource) validateConfigWithoutRegion(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	v := w.newRegionValues(ctx)
+
+	innerRequest := request
+	innerRequest.Config = v.config(request.Config)
+	if response.Diagnostics.Append(v.diags...); response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ValidateConfig(ctx, innerRequest, response)
+}
+
+// upgradeStateWithoutRegion returns state upgraders which hide the top-level `region` attribute from the inner resource's state upgraders.
+// The resource's Region is set on the next refresh.
+func (w *wrappedResource) upgradeStateWithoutRegion(ctx context.Context, upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	wrapped := make(map[int64]resource.StateUpgrader, len(upgraders))
+
+	for version, upgrader := range upgraders {
+		f := upgrader.StateUpgrader
+		upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			v := w.newRegionValues(ctx)
+			state, typ := response.State, response.State.Schema.Type().TerraformType(ctx)
+
+			innerResponse := *response
+			innerResponse.State, _ = v.state(response.State)
+			if response.Diagnostics.Append(v.diags...); response.Diagnostics.HasError() {
+				return
+			}
+
+			f(ctx, request, &innerResponse)
+
+			*response = innerResponse
+			response.State = tfsdk.State{Schema: state.Schema, Raw: v.with(typ, innerResponse.State.Raw, tftypes.NewValue(tftypes.String, nil))}
+			response.Diagnostics.Append(v.diags...)
+		}
+		wrapped[version] = upgrader
+	}
+
+	return wrapped
+}
+
+func (w *wrappedDataSource) addRegionToSchema(response *datasource.SchemaResponse) {
+	if _, ok := response.Schema.Attributes[names.AttrRegion]; ok {
+		response.Diagnostics.AddError("Invalid Data Source Schema", fmt.Sprintf("`%s` attribute already defined in schema.", names.AttrRegion))
+		return
+	}
+
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]dsschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = regionDataSourceAttribute()
+}
+
+// readWithRegion calls the inner data source's Read, hiding the top-level `region` attribute from it.
+func (w *wrappedDataSource) readWithRegion(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	schemaResponse := datasource.SchemaResponse{}
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+	if response.Diagnostics.Append(schemaResponse.Diagnostics...); response.Diagnostics.HasError() {
+		return
+	}
+
+	innerSchema := schemaResponse.Schema
+	innerType := innerSchema.Type().TerraformType(ctx)
+	state, typ := response.State, response.State.Schema.Type().TerraformType(ctx)
+
+	config, region, err := withoutRegion(innerType, request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing Region", err.Error())
+		return
+	}
+	innerState, _, err := withoutRegion(innerType, response.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing Region", err.Error())
+		return
+	}
+
+	if region.IsKnown() && !region.IsNull() {
+		var s string
+		if err := region.As(&s); err == nil {
+			setOverrideRegion(ctx, s)
+		}
+	}
+
+	// The inner data source's client is for the data source's effective Region.
+	if w.meta != nil {
+		configureResponse := datasource.ConfigureResponse{}
+		w.inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: w.meta.ForRegion(w.meta.EffectiveRegion(ctx))}, &configureResponse)
+		if response.Diagnostics.Append(configureResponse.Diagnostics...); response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Schema: innerSchema, Raw: config}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Schema: innerSchema, Raw: innerState}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	raw, err := withRegion(typ, innerResponse.State.Raw, regionOrDefault(ctx, w.meta, region))
+	*response = innerResponse
+	response.State = tfsdk.State{Schema: state.Schema, Raw: raw}
+	if err != nil {
+		response.Diagnostics.AddError("Adding Region", err.Error())
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// identityResourceData is implemented by *schema.ResourceData.
+type identityResourceData interface {
+	schemaResourceData
+	Identity() (*schema.IdentityData, error)
+}
+
+// newResourceIdentity returns the Plugin SDK v2 identity schema for the specified resource identity.
+func newResourceIdentity(v *types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := make(map[string]*schema.Schema, len(v.Attributes))
+
+			for _, attr := range v.Attributes {
+				s[attr.Name] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: attr.Required,
+					OptionalForImport: !attr.Required,
+				}
+			}
+
+			return s
+		},
+	}
+}
+
+// identityInterceptor sets the resource's identity after Create, Read and Update.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.identity == nil {
+		return ctx, diags
+	}
+
+	rd, ok := d.(identityResourceData)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if rd.Id() == "" {
+				return ctx, diags
+			}
+
+			identity, err := rd.Identity()
+			if err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "getting resource identity: %s", err)
+			}
+
+			client := meta.(*conns.AWSClient)
+
+			for _, attr := range r.identity.Attributes {
+				var v string
+
+				switch {
+				case !attr.Required && attr.Name == names.AttrAccountID:
+					v = client.AccountID
+				case !attr.Required && attr.Name == names.AttrRegion:
+					v = client.Region
+				case attr.Name == names.AttrID:
+					v = rd.Id()
+				default:
+					v, _ = rd.Get(attr.Name).(string)
+				}
+
+				if err := identity.Set(attr.Name, v); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting resource identity attribute (%s): %s", attr.Name, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// importByIdentity returns an importer that, when the resource is being imported by identity rather than by ID,
+// sets the resource's ID from its identity before calling the specified importer.
+func importByIdentity(identity *types.ServicePackageResourceIdentity, regionOverrideEnabled bool, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			id, err := importIDFromIdentity(d, identity, regionOverrideEnabled, meta.(*conns.AWSClient))
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// importIDFromIdentity returns the import ID corresponding to the resource's identity.
+// The values of the identity's required attributes are joined using the standard resource ID separator.
+// If the resource's Region can be overridden, an identity Region that differs from the provider's is appended as `@<region>`.
+func importIDFromIdentity(d identityResourceData, identity *types.ServicePackageResourceIdentity, regionOverrideEnabled bool, client *conns.AWSClient) (string, error) {
+	v, err := d.Identity()
+	if err != nil {
+		return "", fmt.Errorf("getting resource identity: %w", err)
+	}
+
+	var parts []string
+	var region string
+
+	for _, attr := range identity.Attributes {
+		s, _ := v.Get(attr.Name).(string)
+
+		switch {
+		case attr.Required:
+			if s == "" {
+				return "", fmt.Errorf("resource identity attribute (%s) is required", attr.Name)
+			}
+
+			parts = append(parts, s)
+		case attr.Name == names.AttrAccountID:
+			if s != "" && s != client.AccountID {
+				return "", fmt.Errorf("resource identity account ID (%s) does not match provider account ID (%s)", s, client.AccountID)
+			}
+		case attr.Name == names.AttrRegion:
+			if s != "" && s != client.Region {
+				if !regionOverrideEnabled {
+					return "", fmt.Errorf("resource identity Region (%s) does not match provider Region (%s)", s, client.Region)
+				}
+
+				region = s
+			}
+		}
+	}
+
+	id := strings.Join(parts, flex.ResourceIdSeparator)
+	if region != "" {
+		id += "@" + region
+	}
+
+	return id, nil
+}
+
+// validateResourceIdentity checks that the resource identity's natural key attributes are defined in the resource's schema.
+func validateResourceIdentity(identity *types.ServicePackageResourceIdentity, s map[string]*schema.Schema) error {
+	if len(identity.Attributes) == 0 {
+		return fmt.Errorf("resource identity has no attributes")
+	}
+
+	for _, attr := range identity.Attributes {
+		if !attr.Required || attr.Name == names.AttrID {
+			continue
+		}
+
+		v, ok := s[attr.Name]
+		if !ok {
+			return fmt.Errorf("resource identity attribute (%s) not defined in schema", attr.Name)
+		}
+		if v.Type != schema.TypeString {
+			return fmt.Errorf("resource identity attribute (%s) must be of type string", attr.Name)
+		}
+		if v.Sensitive || v.WriteOnly {
+			return fmt.Errorf("resource identity attribute (%s) cannot be Sensitive or WriteOnly", attr.Name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateResourceIdentity checks that the specified Plugin SDK v2 resource conforms to its declared resource identity.
+func ValidateResourceIdentity(v *types.ServicePackageSDKResource) error {
+	if v.Identity == nil {
+		return nil
+	}
+
+	if err := validateResourceIdentity(v.Identity, v.Factory().SchemaMap()); err != nil {
+		return err
+	}
+
+	return newResourceIdentity(v.Identity).InternalIdentityValidate()
+}
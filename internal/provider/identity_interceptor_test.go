@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func testIdentityResource(identity *types.ServicePackageResourceIdentity) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		Identity: newResourceIdentity(identity),
+	}
+}
+
+func TestIdentityInterceptor(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []types.IdentityAttribute{
+			{Name: "account_id"},
+			{Name: "region"},
+			{Name: "parent", Required: true},
+			{Name: "name", Required: true},
+		},
+	}
+	r := testIdentityResource(identity)
+
+	if err := r.Identity.InternalIdentityValidate(); err != nil {
+		t.Fatalf("unexpected identity schema error: %s", err)
+	}
+	if err := validateResourceIdentity(identity, r.SchemaMap()); err != nil {
+		t.Fatalf("unexpected resource identity error: %s", err)
+	}
+
+	client := &conns.AWSClient{
+		AccountID: "123456789012",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	d := r.Data(nil)
+	d.SetId("p1,n1")
+	d.Set("name", "n1")
+	d.Set("parent", "p1")
+
+	var diags diag.Diagnostics
+	_, diags = identityInterceptor{identity: identity}.run(context.Background(), d, client, After, Read, diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	v, err := d.Identity()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for k, want := range map[string]string{
+		"account_id": "123456789012",
+		"region":     "us-west-2", //lintignore:AWSAT003
+		"parent":     "p1",
+		"name":       "n1",
+	} {
+		if got := v.Get(k); got != want {
+			t.Errorf("identity attribute %s = %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestImportIDFromIdentity(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []types.IdentityAttribute{
+			{Name: "account_id"},
+			{Name: "region"},
+			{Name: "parent", Required: true},
+			{Name: "name", Required: true},
+		},
+	}
+	client := &conns.AWSClient{
+		AccountID: "123456789012",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := map[string]struct {
+		identity              map[string]string
+		regionOverrideEnabled bool
+		expectedID            string
+		expectedError         bool
+	}{
+		"natural key only": {
+			identity: map[string]string{
+				"parent": "p1",
+				"name":   "n1",
+			},
+			expectedID: "p1,n1",
+		},
+		"matching account and Region": {
+			identity: map[string]string{
+				"account_id": "123456789012",
+				"region":     "us-west-2", //lintignore:AWSAT003
+				"parent":     "p1",
+				"name":       "n1",
+			},
+			expectedID: "p1,n1",
+		},
+		"different account": {
+			identity: map[string]string{
+				"account_id": "210987654321",
+				"parent":     "p1",
+				"name":       "n1",
+			},
+			expectedError: true,
+		},
+		"different Region": {
+			identity: map[string]string{
+				"region": "eu-west-1", //lintignore:AWSAT003
+				"parent": "p1",
+				"name":   "n1",
+			},
+			expectedError: true,
+		},
+		"different Region with Region override": {
+			identity: map[string]string{
+				"region": "eu-west-1", //lintignore:AWSAT003
+				"parent": "p1",
+				"name":   "n1",
+			},
+			regionOverrideEnabled: true,
+			expectedID:            "p1,n1@eu-west-1", //lintignore:AWSAT003
+		},
+		"missing natural key attribute": {
+			identity: map[string]string{
+				"parent": "p1",
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := testIdentityResource(identity).Data(nil)
+			v, err := d.Identity()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			for k, s := range testCase.identity {
+				if err := v.Set(k, s); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			got, err := importIDFromIdentity(d, identity, testCase.regionOverrideEnabled, client)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("expected error %t, got %t: %v", want, got, err)
+			}
+			if got != testCase.expectedID {
+				t.Errorf("import ID = %q, want %q", got, testCase.expectedID)
+			}
+		})
+	}
+}
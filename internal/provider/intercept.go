@@ -106,6 +106,11 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 			}
 		}
 
+		// The handler and all other interceptors are passed a client for the resource's effective Region.
+		if v, ok := meta.(*conns.AWSClient); ok {
+			meta = v.ForRegion(v.EffectiveRegion(ctx))
+		}
+
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
 		diags = f(ctx, d, meta)
@@ -17,12 +17,14 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -48,6 +50,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for the audit log of mutating AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "File to which the audit log is appended, one JSON object per line.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -131,12 +148,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"mock": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for recording and replaying AWS API interactions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cassette_dir": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Directory to which AWS API interactions are recorded and from which they are replayed.",
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(vcr.Modes(), false),
+							Description:  "Whether to record interactions with AWS or to replay recorded interactions. Valid values are `record` and `replay`.",
+						},
+					},
+				},
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": rateLimitsSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -269,6 +308,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				return ctx
 			}
 			interceptors := interceptorItems{}
+
+			if v.Region != nil && v.Region.IsOverrideEnabled {
+				// The data source's Region can be overridden.
+				// Add a top-level `region` argument to the schema.
+				if err := addRegionToSchema(r, regionDataSourceSchema); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("%s: %w", typeName, err))
+					continue
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -320,6 +375,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				return ctx
 			}
 			interceptors := interceptorItems{}
+			regionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+
+			if regionOverrideEnabled {
+				// The resource's Region can be overridden.
+				// Add a top-level `region` argument to the schema.
+				if err := addRegionToSchema(r, regionResourceSchema); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("%s: %w", typeName, err))
+					continue
+				}
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(regionCustomizeDiff, customizeDiffWithRegion(v))
+				} else {
+					r.CustomizeDiff = regionCustomizeDiff
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before | Finally,
+				why:         Create | Update | Delete,
+				interceptor: auditLogInterceptor{typeName: typeName},
+			})
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -356,6 +439,25 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if v.Identity != nil {
+				schema := r.SchemaMap()
+
+				// The resource has a resource identity.
+				// Ensure that the natural key attributes are defined in the schema.
+				if err := validateResourceIdentity(v.Identity, schema); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("%s: %w", typeName, err))
+					continue
+				}
+
+				r.Identity = newResourceIdentity(v.Identity)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: identityInterceptor{identity: v.Identity},
+				})
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -373,9 +475,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.DeleteWithoutTimeout; v != nil {
 				r.DeleteWithoutTimeout = rs.Delete(v)
 			}
-			if v := r.Importer; v != nil {
-				if v := v.StateContext; v != nil {
-					r.Importer.StateContext = rs.State(v)
+			if importer := r.Importer; importer != nil {
+				if f := importer.StateContext; f != nil {
+					if regionOverrideEnabled {
+						f = importWithRegion(f)
+					}
+					if v.Identity != nil {
+						f = importByIdentity(v.Identity, regionOverrideEnabled, f)
+					}
+					r.Importer.StateContext = rs.State(f)
 				}
 			}
 			if v := r.CustomizeDiff; v != nil {
@@ -486,6 +594,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.Endpoints = endpoints
 	}
 
+	if v, ok := d.GetOk("audit_log"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.AuditLogPath = v.([]interface{})[0].(map[string]interface{})["path"].(string)
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -498,6 +610,25 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("mock"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		config.MockCassetteDir = tfMap["cassette_dir"].(string)
+		config.MockMode = tfMap["mode"].(string)
+	} else if v := os.Getenv(vcr.EnvVarMode); v != "" {
+		config.MockCassetteDir = os.Getenv(vcr.EnvVarCassetteDir)
+		config.MockMode = v
+	}
+
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, err := expandRateLimits(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -678,6 +809,36 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func rateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block with settings for client-side rate limiting of AWS API requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of requests that can be sent at once. Defaults to `requests_per_second` rounded up.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatAtLeast(0.01),
+					Description:  "The sustained number of AWS API requests per second.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+					Description:  "The service to rate limit, e.g. `ec2`. Valid values are the service names used in the `endpoints` configuration block.",
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -849,3 +1010,37 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 
 	return endpoints, nil
 }
+
+func expandRateLimits(_ context.Context, tfList []interface{}) (map[string]conns.RateLimit, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		alias := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign rate limit (%s): %w", alias, err)
+		}
+
+		if _, ok := rateLimits[pkg]; ok {
+			return nil, fmt.Errorf("duplicate rate limit for service (%s)", alias)
+		}
+
+		rateLimits[pkg] = conns.RateLimit{
+			Burst:             tfMap["burst"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+	}
+
+	return rateLimits, nil
+}
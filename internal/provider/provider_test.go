@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -205,3 +206,47 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rateLimits := []interface{}{
+		map[string]interface{}{
+			"service":             "ec2",
+			"requests_per_second": 20.0,
+			"burst":               40,
+		},
+		map[string]interface{}{
+			"service":             "cloudwatchevents",
+			"requests_per_second": 2.5,
+			"burst":               0,
+		},
+	}
+
+	results, err := expandRateLimits(ctx, rateLimits)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(results) != 2 {
+		t.Errorf("Expected 2 rate limits, got %d", len(results))
+	}
+
+	if v, want := results[names.EC2], (conns.RateLimit{RequestsPerSecond: 20, Burst: 40}); v != want {
+		t.Errorf("Expected rate limit %v, got %v", want, v)
+	}
+
+	if v, want := results[names.Events], (conns.RateLimit{RequestsPerSecond: 2.5}); v != want {
+		t.Errorf("Expected rate limit %v, got %v", want, v)
+	}
+
+	_, err = expandRateLimits(ctx, append(rateLimits, map[string]interface{}{
+		"service":             "events",
+		"requests_per_second": 1.0,
+		"burst":               0,
+	}))
+	if err == nil {
+		t.Errorf("Expected error for duplicate service")
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionResourceSchema returns the schema of the top-level `region` argument added to resources whose Region can be overridden.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionDataSourceSchema returns the schema of the top-level `region` argument added to data sources whose Region can be overridden.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// addRegionToSchema adds a top-level `region` argument to the resource's schema.
+// An error is returned if the schema already defines a top-level `region` attribute.
+func addRegionToSchema(r *schema.Resource, regionSchema func() *schema.Schema) error {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return fmt.Errorf("`%s` attribute already defined in schema", names.AttrRegion)
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := maps.Clone(f())
+			if s == nil {
+				s = make(map[string]*schema.Schema)
+			}
+			s[names.AttrRegion] = regionSchema()
+
+			return s
+		}
+	} else {
+		// Some resources share their schema map, so don't modify it in place.
+		s := maps.Clone(r.Schema)
+		if s == nil {
+			s = make(map[string]*schema.Schema)
+		}
+		s[names.AttrRegion] = regionSchema()
+		r.Schema = s
+	}
+
+	return nil
+}
+
+// regionInterceptor implements per-resource Region override.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = v
+			}
+		}
+	case After:
+		switch why {
+		case Create, Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if v, ok := d.Get(names.AttrRegion).(string); !ok || v == "" {
+				if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff defaults the resource's Region to the provider's configured Region.
+// A change in the provider's configured Region forces the resource to be replaced.
+func regionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	if !config.GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	// Existing resources whose state predates Region override have their Region set on the next refresh.
+	if o, _ := d.GetChange(names.AttrRegion); d.Id() != "" && o.(string) == "" {
+		return nil
+	}
+
+	if region := meta.(*conns.AWSClient).Region; d.Get(names.AttrRegion).(string) != region {
+		return d.SetNew(names.AttrRegion, region)
+	}
+
+	return nil
+}
+
+// customizeDiffWithRegion returns a CustomizeDiff function that calls the specified function with a client for the resource's Region,
+// as the resource's CRUD handlers are called.
+// It must run after regionCustomizeDiff has defaulted the resource's Region.
+func customizeDiffWithRegion(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = v
+			}
+			meta = meta.(*conns.AWSClient).ForRegion(v)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// importWithRegion returns an importer that handles import IDs of the form `<id>@<region>`,
+// setting the resource's Region before calling the specified importer.
+func importWithRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, ok := verify.CutImportIDRegion(d.Id()); ok {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = region
+			}
+			meta = meta.(*conns.AWSClient).ForRegion(region)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAddRegionToSchema(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	if err := addRegionToSchema(r, regionResourceSchema); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+		t.Errorf("expected `%s` attribute in schema", names.AttrRegion)
+	}
+	if err := addRegionToSchema(r, regionResourceSchema); err == nil {
+		t.Errorf("expected error adding duplicate `%s` attribute", names.AttrRegion)
+	}
+
+	r = &schema.Resource{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			}
+		},
+	}
+
+	if err := addRegionToSchema(r, regionDataSourceSchema); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+		t.Errorf("expected `%s` attribute in schema", names.AttrRegion)
+	}
+}
+
+func TestRegionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	if err := addRegionToSchema(r, regionResourceSchema); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &conns.AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	// No Region override.
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test")
+	d := r.Data(nil)
+	d.SetId("n1")
+
+	var diags diag.Diagnostics
+	ctx, diags = regionInterceptor{}.run(ctx, d, client, Before, Read, diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := client.EffectiveRegion(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("effective Region = %s, want %s", got, want)
+	}
+
+	_, diags = regionInterceptor{}.run(ctx, d, client, After, Read, diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := d.Get(names.AttrRegion).(string), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("region = %s, want %s", got, want)
+	}
+
+	// Region override.
+	ctx = conns.NewResourceContext(context.Background(), "test", "Test")
+	d = r.Data(nil)
+	d.SetId("n1")
+	d.Set(names.AttrRegion, "eu-west-1") //lintignore:AWSAT003
+
+	ctx, diags = regionInterceptor{}.run(ctx, d, client, Before, Read, diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := client.EffectiveRegion(ctx), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("effective Region = %s, want %s", got, want)
+	}
+
+	_, diags = regionInterceptor{}.run(ctx, d, client, After, Read, diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := d.Get(names.AttrRegion).(string), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("region = %s, want %s", got, want)
+	}
+}
+
+func TestCustomizeDiffWithRegion(t *testing.T) {
+	t.Parallel()
+
+	var gotRegion, gotEffectiveRegion string
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		CustomizeDiff: customizeDiffWithRegion(func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			gotRegion = meta.(*conns.AWSClient).Region
+			gotEffectiveRegion = meta.(*conns.AWSClient).EffectiveRegion(ctx)
+
+			return nil
+		}),
+	}
+	if err := addRegionToSchema(r, regionResourceSchema); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &conns.AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test")
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		names.AttrName:   "n1",
+		names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+	})
+
+	if _, err := r.Diff(ctx, nil, config, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := gotRegion, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("client Region = %s, want %s", got, want)
+	}
+	if got, want := gotEffectiveRegion, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("effective Region = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const middlewareID = "TerraformRateLimit"
+
+// AddToConfig adds rate limiting to all AWS SDK for Go v2 API clients created from the specified configuration.
+// The limiter is applied after the retry middleware so that each attempt takes a token.
+func (l *Limiter) AddToConfig(cfg *aws_sdkv2.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(middlewareID, l.handleFinalize), middleware.After)
+	})
+}
+
+func (l *Limiter) handleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	if err := l.Wait(ctx); err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+
+	return next.HandleFinalize(ctx, in)
+}
+
+// AddToSession adds rate limiting to all AWS SDK for Go v1 API clients created from the specified session.
+// The Sign handlers are run before each attempt.
+func (l *Limiter) AddToSession(sess *session_sdkv1.Session) {
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: middlewareID,
+		Fn:   l.handleSign,
+	})
+}
+
+func (l *Limiter) handleSign(req *request.Request) {
+	if err := l.Wait(req.Context()); err != nil {
+		req.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ratelimit implements client-side rate limiting of AWS API requests.
+// Requests are throttled per service using a token bucket.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Limiter is a token bucket rate limiter for a single service's AWS API requests.
+// The bucket holds up to burst tokens and is refilled at rate tokens per second.
+type Limiter struct {
+	burst   float64
+	rate    float64
+	service string
+
+	lock   sync.Mutex
+	last   time.Time
+	tokens float64
+
+	// Metrics.
+	delayedRequests int64
+	requests        int64
+	totalWait       time.Duration
+}
+
+var (
+	limitersLock sync.Mutex
+	limiters     = make(map[string]*Limiter)
+)
+
+// Get returns the Limiter for the specified service, rate (requests per second) and burst.
+// Limiters are shared by all provider instances in the process with the same settings.
+func Get(service string, rate float64, burst int) *Limiter {
+	key := fmt.Sprintf("%s/%g/%d", service, rate, burst)
+
+	limitersLock.Lock()
+	defer limitersLock.Unlock()
+
+	if v, ok := limiters[key]; ok {
+		return v
+	}
+
+	limiter := New(service, rate, burst)
+	limiters[key] = limiter
+
+	return limiter
+}
+
+// New returns a new Limiter.
+// If burst is less than 1, the burst size is the rate rounded up.
+func New(service string, rate float64, burst int) *Limiter {
+	b := float64(burst)
+	if burst < 1 {
+		b = math.Max(1, math.Ceil(rate))
+	}
+
+	return &Limiter{
+		burst:   b,
+		rate:    rate,
+		service: service,
+		tokens:  b,
+	}
+}
+
+// Wait blocks until a request may be sent or the Context is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			l.cancel()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	l.lock.Lock()
+	l.requests++
+	if delay > 0 {
+		l.delayedRequests++
+		l.totalWait += delay
+	}
+	requests, delayedRequests, totalWait := l.requests, l.delayedRequests, l.totalWait
+	l.lock.Unlock()
+
+	if delay > 0 {
+		tflog.Debug(ctx, "AWS API request rate limited", map[string]any{
+			"aws.service":            l.service,
+			"rate_limit.wait_ms":     delay.Milliseconds(),
+			"rate_limit.requests":    requests,
+			"rate_limit.delayed":     delayedRequests,
+			"rate_limit.total_wait":  totalWait.String(),
+			"rate_limit.rate":        l.rate,
+			"rate_limit.burst":       l.burst,
+			"rate_limit.tokens_left": l.Tokens(),
+		})
+	}
+
+	return nil
+}
+
+// Tokens returns the number of tokens currently available.
+// The value is negative if requests are waiting.
+func (l *Limiter) Tokens() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.refill(time.Now())
+
+	return l.tokens
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *Limiter) reserve(now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.refill(now)
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *Limiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+func (l *Limiter) refill(now time.Time) {
+	if !l.last.IsZero() {
+		if elapsed := now.Sub(l.last); elapsed > 0 {
+			l.tokens = math.Min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		}
+	}
+	if now.After(l.last) {
+		l.last = now
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	l := New("ec2", 2, 3)
+	now := time.Now()
+
+	// The bucket starts full.
+	for i := 0; i < 3; i++ {
+		if got := l.reserve(now); got != 0 {
+			t.Fatalf("reservation %d: delay = %s, want 0", i, got)
+		}
+	}
+
+	// Subsequent requests wait for the bucket to refill.
+	if got, want := l.reserve(now), 500*time.Millisecond; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+	if got, want := l.reserve(now), 1*time.Second; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+
+	// After refilling, the bucket holds at most burst tokens.
+	now = now.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		if got := l.reserve(now); got != 0 {
+			t.Fatalf("reservation %d: delay = %s, want 0", i, got)
+		}
+	}
+	if got := l.reserve(now); got == 0 {
+		t.Errorf("expected delay")
+	}
+}
+
+func TestLimiterDefaultBurst(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rate float64
+		want float64
+	}{
+		"whole":     {rate: 10, want: 10},
+		"fraction":  {rate: 2.5, want: 3},
+		"less than": {rate: 0.1, want: 1},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := New("iam", testCase.rate, 0).burst, testCase.want; got != want {
+				t.Errorf("burst = %g, want %g", got, want)
+			}
+		})
+	}
+}
+
+func TestLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := New("route53", 0.001, 1)
+	ctx := context.Background()
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+	if got, want := l.requests, int64(1); got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	if Get("ec2", 10, 20) != Get("ec2", 10, 20) {
+		t.Errorf("expected shared limiter")
+	}
+	if Get("ec2", 10, 20) == Get("ec2", 5, 20) {
+		t.Errorf("expected distinct limiters")
+	}
+}
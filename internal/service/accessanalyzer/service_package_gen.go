@@ -35,10 +35,23 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{Name: "account_id"},
+					{Name: "region"},
+					{Name: "id", Required: true},
+				},
+			},
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  resourceArchiveRule,
 			TypeName: "aws_accessanalyzer_archive_rule",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
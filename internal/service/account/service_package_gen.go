@@ -31,10 +31,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  resourceAlternateContact,
 			TypeName: "aws_account_alternate_contact",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  resourcePrimaryContact,
 			TypeName: "aws_account_primary_contact",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
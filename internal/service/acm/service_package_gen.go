@@ -27,6 +27,9 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  dataSourceCertificate,
 			TypeName: "aws_acm_certificate",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
@@ -40,10 +43,23 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{Name: "account_id"},
+					{Name: "region"},
+					{Name: "id", Required: true},
+				},
+			},
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  resourceCertificateValidation,
 			TypeName: "aws_acm_certificate_validation",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
@@ -28,10 +28,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  DataSourceCertificate,
 			TypeName: "aws_acmpca_certificate",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  DataSourceCertificateAuthority,
 			TypeName: "aws_acmpca_certificate_authority",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
@@ -41,6 +47,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceCertificate,
 			TypeName: "aws_acmpca_certificate",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourceCertificateAuthority,
@@ -49,18 +58,37 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{Name: "account_id"},
+					{Name: "region"},
+					{Name: "id", Required: true},
+				},
+			},
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourceCertificateAuthorityCertificate,
 			TypeName: "aws_acmpca_certificate_authority_certificate",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourcePermission,
 			TypeName: "aws_acmpca_permission",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourcePolicy,
 			TypeName: "aws_acmpca_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
@@ -28,10 +28,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  DataSourceWorkspace,
 			TypeName: "aws_prometheus_workspace",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  DataSourceWorkspaces,
 			TypeName: "aws_prometheus_workspaces",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
@@ -41,10 +47,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceAlertManagerDefinition,
 			TypeName: "aws_prometheus_alert_manager_definition",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourceRuleGroupNamespace,
 			TypeName: "aws_prometheus_rule_group_namespace",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourceWorkspace,
@@ -53,6 +65,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{Name: "account_id"},
+					{Name: "region"},
+					{Name: "id", Required: true},
+				},
+			},
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
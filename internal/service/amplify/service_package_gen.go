@@ -36,10 +36,23 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{Name: "account_id"},
+					{Name: "region"},
+					{Name: "id", Required: true},
+				},
+			},
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourceBackendEnvironment,
 			TypeName: "aws_amplify_backend_environment",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourceBranch,
@@ -48,14 +61,30 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{Name: "account_id"},
+					{Name: "region"},
+					{Name: "id", Required: true},
+				},
+			},
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourceDomainAssociation,
 			TypeName: "aws_amplify_domain_association",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  ResourceWebhook,
 			TypeName: "aws_amplify_webhook",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_cloudformation_stack_set_instance")
+// @Region(overrideEnabled=false)
 func ResourceStackSetInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStackSetInstanceCreate,
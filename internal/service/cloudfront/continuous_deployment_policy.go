@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cloudfront_continuous_deployment_policy", name="Continuous Deployment Policy")
+func ResourceContinuousDeploymentPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContinuousDeploymentPolicyCreate,
+		ReadWithoutTimeout:   resourceContinuousDeploymentPolicyRead,
+		UpdateWithoutTimeout: resourceContinuousDeploymentPolicyUpdate,
+		DeleteWithoutTimeout: resourceContinuousDeploymentPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staging_distribution_dns_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"items": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"quantity": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"traffic_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"single_header_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^aws-cf-cd-`), `must begin with "aws-cf-cd-"`),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"single_weight_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"session_stickiness_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"idle_ttl": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(300, 3600),
+												},
+												"maximum_ttl": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(300, 3600),
+												},
+											},
+										},
+									},
+									"weight": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 0.15),
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudfront.ContinuousDeploymentPolicyType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceContinuousDeploymentPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	input := &cloudfront.CreateContinuousDeploymentPolicyInput{
+		ContinuousDeploymentPolicyConfig: expandContinuousDeploymentPolicyConfig(d),
+	}
+
+	output, err := conn.CreateContinuousDeploymentPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFront Continuous Deployment Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ContinuousDeploymentPolicy.Id))
+
+	return append(diags, resourceContinuousDeploymentPolicyRead(ctx, d, meta)...)
+}
+
+func resourceContinuousDeploymentPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	output, err := FindContinuousDeploymentPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Continuous Deployment Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Continuous Deployment Policy (%s): %s", d.Id(), err)
+	}
+
+	policy := output.ContinuousDeploymentPolicy
+	config := policy.ContinuousDeploymentPolicyConfig
+	d.Set("enabled", config.Enabled)
+	d.Set("etag", output.ETag)
+	d.Set("last_modified_time", aws.TimeValue(policy.LastModifiedTime).Format(time.RFC3339))
+	if err := d.Set("staging_distribution_dns_names", flattenStagingDistributionDNSNames(config.StagingDistributionDnsNames)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting staging_distribution_dns_names: %s", err)
+	}
+	if err := d.Set("traffic_config", flattenTrafficConfig(config.TrafficConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting traffic_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceContinuousDeploymentPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	input := &cloudfront.UpdateContinuousDeploymentPolicyInput{
+		ContinuousDeploymentPolicyConfig: expandContinuousDeploymentPolicyConfig(d),
+		Id:                               aws.String(d.Id()),
+		IfMatch:                          aws.String(d.Get("etag").(string)),
+	}
+
+	_, err := conn.UpdateContinuousDeploymentPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CloudFront Continuous Deployment Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceContinuousDeploymentPolicyRead(ctx, d, meta)...)
+}
+
+func resourceContinuousDeploymentPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudFront Continuous Deployment Policy: %s", d.Id())
+	err := deleteContinuousDeploymentPolicy(ctx, conn, d.Id(), d.Get("etag").(string))
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchContinuousDeploymentPolicy) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Continuous Deployment Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func deleteContinuousDeploymentPolicy(ctx context.Context, conn *cloudfront.CloudFront, id, etag string) error {
+	input := &cloudfront.DeleteContinuousDeploymentPolicyInput{
+		Id:      aws.String(id),
+		IfMatch: aws.String(etag),
+	}
+
+	// The policy remains in use until the production distribution it was detached from has been deployed.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, continuousDeploymentPolicyInUseTimeout, func() (interface{}, error) {
+		return conn.DeleteContinuousDeploymentPolicyWithContext(ctx, input)
+	}, cloudfront.ErrCodeContinuousDeploymentPolicyInUse)
+
+	// Refresh our ETag if it is out of date and attempt deletion again.
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodePreconditionFailed, cloudfront.ErrCodeInvalidIfMatchVersion) {
+		var output *cloudfront.GetContinuousDeploymentPolicyOutput
+		output, err = FindContinuousDeploymentPolicyByID(ctx, conn, id)
+
+		if err != nil {
+			return err
+		}
+
+		input.IfMatch = output.ETag
+
+		_, err = conn.DeleteContinuousDeploymentPolicyWithContext(ctx, input)
+	}
+
+	return err
+}
+
+const (
+	continuousDeploymentPolicyInUseTimeout = 5 * time.Minute
+)
+
+func FindContinuousDeploymentPolicyByID(ctx context.Context, conn *cloudfront.CloudFront, id string) (*cloudfront.GetContinuousDeploymentPolicyOutput, error) {
+	input := &cloudfront.GetContinuousDeploymentPolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetContinuousDeploymentPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchContinuousDeploymentPolicy) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContinuousDeploymentPolicy == nil || output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandContinuousDeploymentPolicyConfig(d *schema.ResourceData) *cloudfront.ContinuousDeploymentPolicyConfig {
+	apiObject := &cloudfront.ContinuousDeploymentPolicyConfig{
+		Enabled: aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("staging_distribution_dns_names"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.StagingDistributionDnsNames = expandStagingDistributionDNSNames(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("traffic_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.TrafficConfig = expandTrafficConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandStagingDistributionDNSNames(tfMap map[string]interface{}) *cloudfront.StagingDistributionDnsNames {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.StagingDistributionDnsNames{}
+
+	if v, ok := tfMap["items"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Items = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["quantity"].(int); ok {
+		apiObject.Quantity = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandTrafficConfig(tfMap map[string]interface{}) *cloudfront.TrafficConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.TrafficConfig{}
+
+	if v, ok := tfMap["single_header_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SingleHeaderConfig = expandContinuousDeploymentSingleHeaderConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["single_weight_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SingleWeightConfig = expandContinuousDeploymentSingleWeightConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContinuousDeploymentSingleHeaderConfig(tfMap map[string]interface{}) *cloudfront.ContinuousDeploymentSingleHeaderConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.ContinuousDeploymentSingleHeaderConfig{}
+
+	if v, ok := tfMap["header"].(string); ok && v != "" {
+		apiObject.Header = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContinuousDeploymentSingleWeightConfig(tfMap map[string]interface{}) *cloudfront.ContinuousDeploymentSingleWeightConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.ContinuousDeploymentSingleWeightConfig{}
+
+	if v, ok := tfMap["session_stickiness_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SessionStickinessConfig = expandSessionStickinessConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["weight"].(float64); ok {
+		apiObject.Weight = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandSessionStickinessConfig(tfMap map[string]interface{}) *cloudfront.SessionStickinessConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.SessionStickinessConfig{}
+
+	if v, ok := tfMap["idle_ttl"].(int); ok {
+		apiObject.IdleTTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_ttl"].(int); ok {
+		apiObject.MaximumTTL = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenStagingDistributionDNSNames(apiObject *cloudfront.StagingDistributionDnsNames) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"items":    aws.StringValueSlice(apiObject.Items),
+		"quantity": aws.Int64Value(apiObject.Quantity),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrafficConfig(apiObject *cloudfront.TrafficConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": aws.StringValue(apiObject.Type),
+	}
+
+	if v := apiObject.SingleHeaderConfig; v != nil {
+		tfMap["single_header_config"] = flattenContinuousDeploymentSingleHeaderConfig(v)
+	}
+
+	if v := apiObject.SingleWeightConfig; v != nil {
+		tfMap["single_weight_config"] = flattenContinuousDeploymentSingleWeightConfig(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContinuousDeploymentSingleHeaderConfig(apiObject *cloudfront.ContinuousDeploymentSingleHeaderConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"header": aws.StringValue(apiObject.Header),
+		"value":  aws.StringValue(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContinuousDeploymentSingleWeightConfig(apiObject *cloudfront.ContinuousDeploymentSingleWeightConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"weight": aws.Float64Value(apiObject.Weight),
+	}
+
+	if v := apiObject.SessionStickinessConfig; v != nil {
+		tfMap["session_stickiness_config"] = flattenSessionStickinessConfig(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSessionStickinessConfig(apiObject *cloudfront.SessionStickinessConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"idle_ttl":    aws.Int64Value(apiObject.IdleTTL),
+		"maximum_ttl": aws.Int64Value(apiObject.MaximumTTL),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudFrontContinuousDeploymentPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var stagingDistribution, productionDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName),
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.0.items.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "staging_distribution_dns_names.0.items.*", stagingDistributionResourceName, "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.0.quantity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleWeight"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.01"),
+					resource.TestCheckResourceAttr(stagingDistributionResourceName, "staging", "true"),
+					resource.TestCheckResourceAttr(productionDistributionResourceName, "staging", "false"),
+					resource.TestCheckResourceAttrPair(productionDistributionResourceName, "continuous_deployment_policy_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var stagingDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_disappears(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudfront.ResourceContinuousDeploymentPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_trafficConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var stagingDistribution, productionDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_trafficConfigSingleWeight(false, "0.01", 300, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName),
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleWeight"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.01"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.idle_ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.maximum_ttl", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_trafficConfigSingleWeight(true, "0.15", 3600, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.15"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.idle_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.maximum_ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_trafficConfigSingleHeader(false, "aws-cf-cd-test", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleHeader"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.header", "aws-cf-cd-test"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.value", "test"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "0"),
+				),
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_trafficConfigSingleHeader(true, "aws-cf-cd-test2", "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.header", "aws-cf-cd-test2"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.value", "test2"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccCloudFrontContinuousDeploymentPolicy_promotion verifies that configuration
+// changes made to the staging distribution are promoted to the production distribution.
+func TestAccCloudFrontContinuousDeploymentPolicy_promotion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var stagingDistribution, productionDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_promotion("https-only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName),
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(productionDistributionResourceName, "default_cache_behavior.0.viewer_protocol_policy", "https-only"),
+				),
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_promotion("redirect-to-https"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(stagingDistributionResourceName, "default_cache_behavior.0.viewer_protocol_policy", "redirect-to-https"),
+					resource.TestCheckResourceAttr(productionDistributionResourceName, "default_cache_behavior.0.viewer_protocol_policy", "redirect-to-https"),
+					resource.TestCheckResourceAttrPair(productionDistributionResourceName, "continuous_deployment_policy_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContinuousDeploymentPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfront_continuous_deployment_policy" {
+				continue
+			}
+
+			_, err := tfcloudfront.FindContinuousDeploymentPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudFront Continuous Deployment Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContinuousDeploymentPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Continuous Deployment Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
+
+		_, err := tfcloudfront.FindContinuousDeploymentPolicyByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccContinuousDeploymentPolicyConfig_distribution(resourceName string, staging bool, continuousDeploymentPolicyID, viewerProtocolPolicy string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" %[1]q {
+  enabled          = true
+  retain_on_delete = false
+  staging          = %[2]t
+
+  continuous_deployment_policy_id = %[3]s
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = %[4]q
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`, resourceName, staging, continuousDeploymentPolicyID, viewerProtocolPolicy)
+}
+
+func testAccContinuousDeploymentPolicyConfig_base(viewerProtocolPolicy string) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_distribution("staging", true, "null", viewerProtocolPolicy),
+		testAccContinuousDeploymentPolicyConfig_distribution("test", false, "aws_cloudfront_continuous_deployment_policy.test.id", viewerProtocolPolicy),
+	)
+}
+
+func testAccContinuousDeploymentPolicyConfig_basic(enabled bool) string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_base("allow-all"), fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = %[1]t
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+`, enabled))
+}
+
+func testAccContinuousDeploymentPolicyConfig_disappears() string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_distribution("staging", true, "null", "allow-all"), `
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = false
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+`)
+}
+
+func testAccContinuousDeploymentPolicyConfig_trafficConfigSingleWeight(enabled bool, weight string, idleTTL, maximumTTL int) string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_base("allow-all"), fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = %[1]t
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = %[2]q
+
+      session_stickiness_config {
+        idle_ttl    = %[3]d
+        maximum_ttl = %[4]d
+      }
+    }
+  }
+}
+`, enabled, weight, idleTTL, maximumTTL))
+}
+
+func testAccContinuousDeploymentPolicyConfig_trafficConfigSingleHeader(enabled bool, header, value string) string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_base("allow-all"), fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = %[1]t
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleHeader"
+
+    single_header_config {
+      header = %[2]q
+      value  = %[3]q
+    }
+  }
+}
+`, enabled, header, value))
+}
+
+func testAccContinuousDeploymentPolicyConfig_promotion(viewerProtocolPolicy string) string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_base(viewerProtocolPolicy), `
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = true
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+`)
+}
@@ -5,6 +5,7 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -234,6 +235,10 @@ func ResourceDistribution() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 128),
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"custom_error_response": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -719,6 +724,12 @@ func ResourceDistribution() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -929,6 +940,24 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	// While a continuous deployment policy is attached to a production distribution, configuration changes
+	// are made to the staging distribution and applied to the production distribution by promotion.
+	if v, ok := d.GetOk("continuous_deployment_policy_id"); ok && !d.HasChange("continuous_deployment_policy_id") && d.HasChangesExcept("retain_on_delete", "tags", "tags_all", "wait_for_deployment") {
+		if err := promoteDistributionStagingConfig(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "promoting CloudFront Distribution (%s) staging configuration: %s", d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
+			if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+			}
+		}
+
+		return append(diags, resourceDistributionRead(ctx, d, meta)...)
+	}
+
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
 		DistributionConfig: expandDistributionConfig(d),
@@ -1028,9 +1057,44 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
+	etag := d.Get("etag").(string)
+
+	// A continuous deployment policy must be detached from the production distribution
+	// before either the policy or the staging distribution can be deleted.
+	if v := d.Get("continuous_deployment_policy_id").(string); v != "" {
+		output, err := FindDistributionByID(ctx, conn, d.Id())
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution (%s): %s", d.Id(), err)
+		}
+
+		input := &cloudfront.UpdateDistributionInput{
+			DistributionConfig: output.Distribution.DistributionConfig,
+			Id:                 aws.String(d.Id()),
+			IfMatch:            output.ETag,
+		}
+		input.DistributionConfig.ContinuousDeploymentPolicyId = aws.String("")
+
+		updateOutput, err := conn.UpdateDistributionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detaching CloudFront Continuous Deployment Policy (%s) from Distribution (%s): %s", v, d.Id(), err)
+		}
+
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		}
+
+		etag = aws.StringValue(updateOutput.ETag)
+	}
+
 	deleteDistroInput := &cloudfront.DeleteDistributionInput{
 		Id:      aws.String(d.Id()),
-		IfMatch: aws.String(d.Get("etag").(string)),
+		IfMatch: aws.String(etag),
 	}
 
 	log.Printf("[DEBUG] Deleting CloudFront Distribution: %s", d.Id())
@@ -1133,6 +1197,84 @@ func FindDistributionByID(ctx context.Context, conn *cloudfront.CloudFront, id s
 	return output, nil
 }
 
+func findDistributionByDomainName(ctx context.Context, conn *cloudfront.CloudFront, domainName string) (*cloudfront.DistributionSummary, error) {
+	input := &cloudfront.ListDistributionsInput{}
+	var output *cloudfront.DistributionSummary
+
+	err := conn.ListDistributionsPagesWithContext(ctx, input, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+		if page == nil || page.DistributionList == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DistributionList.Items {
+			if v != nil && aws.StringValue(v.DomainName) == domainName {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// promoteDistributionStagingConfig copies the configuration of the staging distribution referenced by
+// the specified continuous deployment policy to the production distribution.
+func promoteDistributionStagingConfig(ctx context.Context, conn *cloudfront.CloudFront, id, policyID string) error {
+	policy, err := FindContinuousDeploymentPolicyByID(ctx, conn, policyID)
+
+	if err != nil {
+		return fmt.Errorf("reading CloudFront Continuous Deployment Policy (%s): %w", policyID, err)
+	}
+
+	dnsNames := policy.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig.StagingDistributionDnsNames
+	if dnsNames == nil || len(dnsNames.Items) == 0 {
+		return fmt.Errorf("CloudFront Continuous Deployment Policy (%s) has no staging distribution", policyID)
+	}
+
+	domainName := aws.StringValue(dnsNames.Items[0])
+	staging, err := findDistributionByDomainName(ctx, conn, domainName)
+
+	if err != nil {
+		return fmt.Errorf("reading CloudFront Distribution (%s): %w", domainName, err)
+	}
+
+	stagingID := aws.StringValue(staging.Id)
+	stagingOutput, err := FindDistributionByID(ctx, conn, stagingID)
+
+	if err != nil {
+		return fmt.Errorf("reading CloudFront Distribution (%s): %w", stagingID, err)
+	}
+
+	primaryOutput, err := FindDistributionByID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading CloudFront Distribution (%s): %w", id, err)
+	}
+
+	input := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id:                    aws.String(id),
+		IfMatch:               aws.String(fmt.Sprintf("%s, %s", aws.StringValue(primaryOutput.ETag), aws.StringValue(stagingOutput.ETag))),
+		StagingDistributionId: aws.String(stagingID),
+	}
+
+	_, err = conn.UpdateDistributionWithStagingConfigWithContext(ctx, input)
+
+	return err
+}
+
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
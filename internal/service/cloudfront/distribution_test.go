@@ -1110,6 +1110,8 @@ func TestAccCloudFrontDistribution_enabled(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDistributionExists(ctx, resourceName, &distribution),
 					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "continuous_deployment_policy_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "staging", "false"),
 				),
 			},
 			{
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cloudfront_key_value_store", name="Key Value Store")
+// @IdentityAttribute("name")
+func ResourceKeyValueStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyValueStoreCreate,
+		ReadWithoutTimeout:   resourceKeyValueStoreRead,
+		UpdateWithoutTimeout: resourceKeyValueStoreUpdate,
+		DeleteWithoutTimeout: resourceKeyValueStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+		},
+	}
+}
+
+func resourceKeyValueStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateKeyValueStoreInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateKeyValueStoreWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFront Key Value Store (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitKeyValueStoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Key Value Store (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceKeyValueStoreRead(ctx, d, meta)...)
+}
+
+func resourceKeyValueStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	output, err := FindKeyValueStoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Key Value Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Key Value Store (%s): %s", d.Id(), err)
+	}
+
+	kvs := output.KeyValueStore
+	d.Set("arn", kvs.ARN)
+	d.Set("comment", kvs.Comment)
+	d.Set("etag", output.ETag)
+	d.Set("last_modified_time", aws.TimeValue(kvs.LastModifiedTime).Format(time.RFC3339))
+	d.Set("name", kvs.Name)
+
+	return diags
+}
+
+func resourceKeyValueStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	input := &cloudfront.UpdateKeyValueStoreInput{
+		Comment: aws.String(d.Get("comment").(string)),
+		IfMatch: aws.String(d.Get("etag").(string)),
+		Name:    aws.String(d.Id()),
+	}
+
+	_, err := conn.UpdateKeyValueStoreWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CloudFront Key Value Store (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceKeyValueStoreRead(ctx, d, meta)...)
+}
+
+func resourceKeyValueStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudFront Key Value Store: %s", d.Id())
+	_, err := conn.DeleteKeyValueStoreWithContext(ctx, &cloudfront.DeleteKeyValueStoreInput{
+		IfMatch: aws.String(d.Get("etag").(string)),
+		Name:    aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeEntityNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Key Value Store (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindKeyValueStoreByName(ctx context.Context, conn *cloudfront.CloudFront, name string) (*cloudfront.DescribeKeyValueStoreOutput, error) {
+	input := &cloudfront.DescribeKeyValueStoreInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeKeyValueStoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeEntityNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.KeyValueStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const (
+	keyValueStoreStatusProvisioning = "PROVISIONING"
+	keyValueStoreStatusReady        = "READY"
+)
+
+func statusKeyValueStore(ctx context.Context, conn *cloudfront.CloudFront, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindKeyValueStoreByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.KeyValueStore.Status), nil
+	}
+}
+
+func waitKeyValueStoreCreated(ctx context.Context, conn *cloudfront.CloudFront, name string, timeout time.Duration) (*cloudfront.DescribeKeyValueStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{keyValueStoreStatusProvisioning},
+		Target:  []string{keyValueStoreStatusReady},
+		Refresh: statusKeyValueStore(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.DescribeKeyValueStoreOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
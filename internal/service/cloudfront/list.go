@@ -29,6 +29,23 @@ func ListCachePoliciesPages(ctx context.Context, conn *cloudfront.CloudFront, in
 	return nil
 }
 
+func ListContinuousDeploymentPoliciesPages(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListContinuousDeploymentPoliciesInput, fn func(*cloudfront.ListContinuousDeploymentPoliciesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListContinuousDeploymentPoliciesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.ContinuousDeploymentPolicyList.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.ContinuousDeploymentPolicyList.NextMarker
+	}
+	return nil
+}
+
 func ListFieldLevelEncryptionConfigsPages(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListFieldLevelEncryptionConfigsInput, fn func(*cloudfront.ListFieldLevelEncryptionConfigsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListFieldLevelEncryptionConfigsWithContext(ctx, input)
@@ -130,3 +147,20 @@ func ListOriginAccessControlsPages(ctx context.Context, conn *cloudfront.CloudFr
 	}
 	return nil
 }
+
+func ListKeyValueStoresPages(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListKeyValueStoresInput, fn func(*cloudfront.ListKeyValueStoresOutput, bool) bool) error {
+	for {
+		output, err := conn.ListKeyValueStoresWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.KeyValueStoreList.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.KeyValueStoreList.NextMarker
+	}
+	return nil
+}
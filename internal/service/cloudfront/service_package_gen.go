@@ -70,6 +70,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCachePolicy,
 			TypeName: "aws_cloudfront_cache_policy",
 		},
+		{
+			Factory:  ResourceContinuousDeploymentPolicy,
+			TypeName: "aws_cloudfront_continuous_deployment_policy",
+			Name:     "Continuous Deployment Policy",
+		},
 		{
 			Factory:  ResourceDistribution,
 			TypeName: "aws_cloudfront_distribution",
@@ -77,6 +82,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{Name: "account_id"},
+					{Name: "id", Required: true},
+				},
+			},
 		},
 		{
 			Factory:  ResourceFieldLevelEncryptionConfig,
@@ -94,6 +105,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceKeyGroup,
 			TypeName: "aws_cloudfront_key_group",
 		},
+		{
+			Factory:  ResourceKeyValueStore,
+			TypeName: "aws_cloudfront_key_value_store",
+			Name:     "Key Value Store",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{Name: "account_id"},
+					{Name: "name", Required: true},
+				},
+			},
+		},
 		{
 			Factory:  ResourceMonitoringSubscription,
 			TypeName: "aws_cloudfront_monitoring_subscription",
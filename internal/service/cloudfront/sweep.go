@@ -27,6 +27,14 @@ func init() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_cloudfront_continuous_deployment_policy", &resource.Sweeper{
+		Name: "aws_cloudfront_continuous_deployment_policy",
+		F:    sweepContinuousDeploymentPolicies,
+		Dependencies: []string{
+			"aws_cloudfront_distribution",
+		},
+	})
+
 	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
@@ -55,6 +63,11 @@ func init() {
 		F:    sweepKeyGroup,
 	})
 
+	resource.AddTestSweepers("aws_cloudfront_key_value_store", &resource.Sweeper{
+		Name: "aws_cloudfront_key_value_store",
+		F:    sweepKeyValueStores,
+	})
+
 	resource.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
 		Name: "aws_cloudfront_monitoring_subscription",
 		F:    sweepMonitoringSubscriptions,
@@ -150,6 +163,64 @@ func sweepCachePolicies(region string) error {
 	return nil
 }
 
+func sweepContinuousDeploymentPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.CloudFrontConn(ctx)
+	input := &cloudfront.ListContinuousDeploymentPoliciesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = ListContinuousDeploymentPoliciesPages(ctx, conn, input, func(page *cloudfront.ListContinuousDeploymentPoliciesOutput, lastPage bool) bool {
+		if page == nil || page.ContinuousDeploymentPolicyList == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ContinuousDeploymentPolicyList.Items {
+			id := aws.StringValue(v.ContinuousDeploymentPolicy.Id)
+
+			output, err := FindContinuousDeploymentPolicyByID(ctx, conn, id)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+
+			r := ResourceContinuousDeploymentPolicy()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("etag", output.ETag)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudFront Continuous Deployment Policy sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudFront Continuous Deployment Policies (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudFront Continuous Deployment Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepDistributions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -326,6 +397,64 @@ func sweepKeyGroup(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepKeyValueStores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.CloudFrontConn(ctx)
+	input := &cloudfront.ListKeyValueStoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = ListKeyValueStoresPages(ctx, conn, input, func(page *cloudfront.ListKeyValueStoresOutput, lastPage bool) bool {
+		if page == nil || page.KeyValueStoreList == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.KeyValueStoreList.Items {
+			name := aws.StringValue(v.Name)
+
+			output, err := FindKeyValueStoreByName(ctx, conn, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+
+			r := ResourceKeyValueStore()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("etag", output.ETag)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudFront Key Value Store sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudFront Key Value Stores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudFront Key Value Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepMonitoringSubscriptions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore
+
+// Exports for use in tests only.
+var (
+	FindKeyByTwoPartKey = findKeyByTwoPartKey
+	ResourceKey         = newResourceKey
+)
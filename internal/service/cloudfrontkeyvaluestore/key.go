@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Key")
+// @IdentityAttribute("key_value_store_arn")
+// @IdentityAttribute("key")
+func newResourceKey(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceKey{}, nil
+}
+
+type resourceKey struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceKey) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfrontkeyvaluestore_key"
+}
+
+func (r *resourceKey) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"key_value_store_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed: true,
+			},
+			"value": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceKey) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceKeyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN, key := data.KeyValueStoreARN.ValueString(), data.Key.ValueString()
+	id, err := flex.FlattenResourceId([]string{kvsARN, key}, keyResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating CloudFront KeyValueStore Key", err.Error())
+
+		return
+	}
+
+	output, err := putKey(ctx, conn, kvsARN, key, data.Value.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront KeyValueStore Key (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceKey) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceKeyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN, key, err := keyParseResourceID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	output, err := findKeyByTwoPartKey(ctx, conn, kvsARN, key)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore Key (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Key = fwflex.StringToFramework(ctx, output.Key)
+	data.KeyValueStoreARN = types.StringValue(kvsARN)
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+	data.Value = fwflex.StringToFramework(ctx, output.Value)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceKey) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new resourceKeyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	output, err := putKey(ctx, conn, new.KeyValueStoreARN.ValueString(), new.Key.ValueString(), new.Value.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront KeyValueStore Key (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceKey) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceKeyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KeyValueStoreARN.ValueString()
+
+	// Every change to a key value store requires the store's current ETag.
+	conns.GlobalMutexKV.Lock(kvsARN)
+	defer conns.GlobalMutexKV.Unlock(kvsARN)
+
+	etag, err := findETagByARN(ctx, conn, kvsARN)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront KeyValueStore Key (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CloudFront KeyValueStore Key", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err = conn.DeleteKey(ctx, &cloudfrontkeyvaluestore.DeleteKeyInput{
+		IfMatch: etag,
+		Key:     fwflex.StringFromFramework(ctx, data.Key),
+		KvsARN:  aws.String(kvsARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront KeyValueStore Key (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceKey) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	kvsARN, key, err := keyParseResourceID(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("key"), key)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("key_value_store_arn"), kvsARN)...)
+}
+
+type resourceKeyData struct {
+	ID               types.String `tfsdk:"id"`
+	Key              types.String `tfsdk:"key"`
+	KeyValueStoreARN types.String `tfsdk:"key_value_store_arn"`
+	TotalSizeInBytes types.Int64  `tfsdk:"total_size_in_bytes"`
+	Value            types.String `tfsdk:"value"`
+}
+
+const keyResourceIDPartCount = 2
+
+// keyParseResourceID splits the resource ID at the first separator, as keys may themselves contain the separator.
+func keyParseResourceID(id string) (string, string, error) {
+	kvsARN, key, found := strings.Cut(id, flex.ResourceIdSeparator)
+
+	if !found || kvsARN == "" || key == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected KEY-VALUE-STORE-ARN%[2]sKEY", id, flex.ResourceIdSeparator)
+	}
+
+	return kvsARN, key, nil
+}
+
+// putKey creates or updates the specified key in the specified key value store.
+func putKey(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN, key, value string) (*cloudfrontkeyvaluestore.PutKeyOutput, error) {
+	// Every change to a key value store requires the store's current ETag.
+	conns.GlobalMutexKV.Lock(kvsARN)
+	defer conns.GlobalMutexKV.Unlock(kvsARN)
+
+	etag, err := findETagByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading CloudFront KeyValueStore (%s): %w", kvsARN, err)
+	}
+
+	input := &cloudfrontkeyvaluestore.PutKeyInput{
+		IfMatch: etag,
+		Key:     aws.String(key),
+		KvsARN:  aws.String(kvsARN),
+		Value:   aws.String(value),
+	}
+
+	return conn.PutKey(ctx, input)
+}
+
+func findETagByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN string) (*string, error) {
+	input := &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+		KvsARN: aws.String(kvsARN),
+	}
+
+	output, err := conn.DescribeKeyValueStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ETag == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ETag, nil
+}
+
+func findKeyByTwoPartKey(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN, key string) (*cloudfrontkeyvaluestore.GetKeyOutput, error) {
+	input := &cloudfrontkeyvaluestore.GetKeyInput{
+		Key:    aws.String(key),
+		KvsARN: aws.String(kvsARN),
+	}
+
+	output, err := conn.GetKey(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Key == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -53,6 +53,7 @@ var ServiceAccountPerRegionMap = map[string]string{
 }
 
 // @SDKDataSource("aws_cloudtrail_service_account")
+// @Region(overrideEnabled=false)
 func DataSourceServiceAccount() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceServiceAccountRead,
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codepipeline_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcodepipeline "github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
+)
+
+func testResourcePipelineStateDataV0() map[string]interface{} {
+	return map[string]interface{}{
+		"id":       "test-pipeline",
+		"name":     "test-pipeline",
+		"role_arn": "arn:aws:iam::123456789012:role/test",
+	}
+}
+
+func testResourcePipelineStateDataV1() map[string]interface{} {
+	v0 := testResourcePipelineStateDataV0()
+	return map[string]interface{}{
+		"id":             v0["id"],
+		"name":           v0["name"],
+		"role_arn":       v0["role_arn"],
+		"execution_mode": "SUPERSEDED",
+		"pipeline_type":  "V1",
+	}
+}
+
+func TestPipelineStateUpgradeV0(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	expected := testResourcePipelineStateDataV1()
+	actual, err := tfcodepipeline.PipelineStateUpgradeV0(ctx, testResourcePipelineStateDataV0(), nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+)
+
+func TestAccComputeOptimizer_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"EnrollmentStatus": {
+			"basic": testAccEnrollmentStatus_basic,
+		},
+		"RecommendationPreferences": {
+			"basic":                     testAccRecommendationPreferences_basic,
+			"disappears":                testAccRecommendationPreferences_disappears,
+			"externalMetricsPreference": testAccRecommendationPreferences_externalMetricsPreference,
+			"lookBackPeriod":            testAccRecommendationPreferences_lookBackPeriod,
+			"update":                    testAccRecommendationPreferences_update,
+			"utilizationPreference":     testAccRecommendationPreferences_utilizationPreference,
+		},
+		"RecommendationsDataSource": {
+			"basic": testAccRecommendationsDataSource_basic,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+// testAccPreCheckEnrolled skips tests that require the account to be opted in to Compute Optimizer.
+func testAccPreCheckEnrolled(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+	output, err := tfcomputeoptimizer.FindEnrollmentStatus(ctx, conn)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if output.Status != awstypes.StatusActive {
+		t.Skipf("skipping acceptance testing: account is not enrolled in Compute Optimizer (%s)", output.Status)
+	}
+}
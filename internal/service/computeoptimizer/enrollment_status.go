@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Enrollment Status")
+func newResourceEnrollmentStatus(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceEnrollmentStatus{}
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type resourceEnrollmentStatus struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceEnrollmentStatus) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_enrollment_status"
+}
+
+func (r *resourceEnrollmentStatus) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"include_member_accounts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"member_accounts_enrolled": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"number_of_member_accounts_opted_in": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.StatusActive, awstypes.StatusInactive)...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceEnrollmentStatus) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceEnrollmentStatusData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	input := &computeoptimizer.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: data.IncludeMemberAccounts.ValueBool(),
+		Status:                awstypes.Status(data.Status.ValueString()),
+	}
+
+	_, err := conn.UpdateEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Compute Optimizer Enrollment Status (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	output, err := waitEnrollmentStatusUpdated(ctx, conn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Compute Optimizer Enrollment Status (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.refreshFromOutput(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceEnrollmentStatus) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceEnrollmentStatusData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := findEnrollmentStatus(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Enrollment Status (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceEnrollmentStatus) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceEnrollmentStatusData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.IncludeMemberAccounts.Equal(old.IncludeMemberAccounts) || !new.Status.Equal(old.Status) {
+		conn := r.Meta().ComputeOptimizerClient(ctx)
+
+		input := &computeoptimizer.UpdateEnrollmentStatusInput{
+			IncludeMemberAccounts: new.IncludeMemberAccounts.ValueBool(),
+			Status:                awstypes.Status(new.Status.ValueString()),
+		}
+
+		_, err := conn.UpdateEnrollmentStatus(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Enrollment Status (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitEnrollmentStatusUpdated(ctx, conn, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Compute Optimizer Enrollment Status (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.refreshFromOutput(ctx, output)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Enrollment can't be removed, so deleting the resource opts the account out.
+func (r *resourceEnrollmentStatus) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceEnrollmentStatusData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Status.ValueString() == string(awstypes.StatusInactive) {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	tflog.Debug(ctx, "deleting Compute Optimizer Enrollment Status", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.UpdateEnrollmentStatus(ctx, &computeoptimizer.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: data.IncludeMemberAccounts.ValueBool(),
+		Status:                awstypes.StatusInactive,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Compute Optimizer Enrollment Status (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceEnrollmentStatusData struct {
+	ID                            types.String   `tfsdk:"id"`
+	IncludeMemberAccounts         types.Bool     `tfsdk:"include_member_accounts"`
+	MemberAccountsEnrolled        types.Bool     `tfsdk:"member_accounts_enrolled"`
+	NumberOfMemberAccountsOptedIn types.Int64    `tfsdk:"number_of_member_accounts_opted_in"`
+	Status                        types.String   `tfsdk:"status"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *resourceEnrollmentStatusData) refreshFromOutput(ctx context.Context, output *computeoptimizer.GetEnrollmentStatusOutput) {
+	data.MemberAccountsEnrolled = types.BoolValue(output.MemberAccountsEnrolled)
+	data.NumberOfMemberAccountsOptedIn = types.Int64Value(int64(aws.ToInt32(output.NumberOfMemberAccountsOptedIn)))
+	data.Status = flex.StringValueToFramework(ctx, output.Status)
+}
+
+func findEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	input := &computeoptimizer.GetEnrollmentStatusInput{}
+
+	output, err := conn.GetEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEnrollmentStatus(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEnrollmentStatusUpdated(ctx context.Context, conn *computeoptimizer.Client, timeout time.Duration) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusPending),
+		Target:  enum.Slice(awstypes.StatusActive, awstypes.StatusInactive),
+		Refresh: statusEnrollmentStatus(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*computeoptimizer.GetEnrollmentStatusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEnrollmentStatus_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnrollmentStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_basic(string(awstypes.StatusActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatus(ctx, resourceName, awstypes.StatusActive),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "false"),
+					resource.TestCheckResourceAttr(resourceName, "member_accounts_enrolled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(awstypes.StatusActive)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"include_member_accounts"},
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_basic(string(awstypes.StatusInactive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatus(ctx, resourceName, awstypes.StatusInactive),
+					resource.TestCheckResourceAttr(resourceName, "status", string(awstypes.StatusInactive)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnrollmentStatusDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_enrollment_status" {
+				continue
+			}
+
+			output, err := tfcomputeoptimizer.FindEnrollmentStatus(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if output.Status == awstypes.StatusInactive {
+				continue
+			}
+
+			return fmt.Errorf("Compute Optimizer Enrollment Status %s still %s", rs.Primary.ID, output.Status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnrollmentStatus(ctx context.Context, n string, want awstypes.Status) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		output, err := tfcomputeoptimizer.FindEnrollmentStatus(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got := output.Status; got != want {
+			return fmt.Errorf("Compute Optimizer Enrollment Status is %s; want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccEnrollmentStatusConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = %[1]q
+}
+`, status)
+}
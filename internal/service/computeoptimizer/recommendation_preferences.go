@@ -0,0 +1,557 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Recommendation Preferences")
+func newResourceRecommendationPreferences(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceRecommendationPreferences{}, nil
+}
+
+type resourceRecommendationPreferences struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceRecommendationPreferences) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_recommendation_preferences"
+}
+
+func (r *resourceRecommendationPreferences) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enhanced_infrastructure_metrics": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.EnhancedInfrastructureMetrics](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"inferred_workload_types": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.InferredWorkloadTypesPreference](),
+				},
+			},
+			"look_back_period": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.LookBackPeriodPreference](),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.ResourceType](),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"external_metrics_preference": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.ExternalMetricsSource](),
+							},
+						},
+					},
+				},
+			},
+			"scope": schema.ListNestedBlock{
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.ScopeName](),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"utilization_preference": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"metric_name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.CustomizableMetricName](),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"metric_parameters": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"headroom": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[awstypes.CustomizableMetricHeadroom](),
+										},
+									},
+									"threshold": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[awstypes.CustomizableMetricThreshold](),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceRecommendationPreferences) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceRecommendationPreferencesData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	scope := fwflex.ExpandFrameworkListNestedBlockPtr(ctx, data.Scope, expandScope)
+	id, err := flex.FlattenResourceId([]string{data.ResourceType.ValueString(), string(scope.Name), aws.ToString(scope.Value)}, recommendationPreferencesResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Compute Optimizer Recommendation Preferences", err.Error())
+
+		return
+	}
+
+	input := data.putRecommendationPreferencesInput(ctx)
+
+	_, err = conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Compute Optimizer Recommendation Preferences (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRecommendationPreferences) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceRecommendationPreferencesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	resourceType, scopeName, scopeValue, err := recommendationPreferencesParseResourceID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, resourceType, scopeName, scopeValue)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Preferences that have not been set are reported with their default values.
+	if !data.EnhancedInfrastructureMetrics.IsNull() || output.EnhancedInfrastructureMetrics != awstypes.EnhancedInfrastructureMetricsInactive {
+		data.EnhancedInfrastructureMetrics = fwflex.StringValueToFramework(ctx, output.EnhancedInfrastructureMetrics)
+	}
+	data.ExternalMetricsPreference = flattenExternalMetricsPreference(ctx, output.ExternalMetricsPreference)
+	if !data.InferredWorkloadTypes.IsNull() || output.InferredWorkloadTypes != awstypes.InferredWorkloadTypesPreferenceInactive {
+		data.InferredWorkloadTypes = fwflex.StringValueToFramework(ctx, output.InferredWorkloadTypes)
+	}
+	if !data.LookBackPeriod.IsNull() || output.LookBackPeriod != awstypes.LookBackPeriodPreferenceDays14 {
+		data.LookBackPeriod = fwflex.StringValueToFramework(ctx, output.LookBackPeriod)
+	}
+	data.ResourceType = fwflex.StringValueToFramework(ctx, output.ResourceType)
+	data.Scope = flattenScope(ctx, output.Scope)
+	data.UtilizationPreference = flattenUtilizationPreferences(ctx, output.UtilizationPreferences)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRecommendationPreferences) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceRecommendationPreferencesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := new.putRecommendationPreferencesInput(ctx)
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Preferences removed from configuration must be deleted explicitly.
+	var preferenceNames []awstypes.RecommendationPreferenceName
+
+	if new.EnhancedInfrastructureMetrics.IsNull() && !old.EnhancedInfrastructureMetrics.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameEnhancedInfrastructureMetrics)
+	}
+
+	if new.ExternalMetricsPreference.IsNull() || len(new.ExternalMetricsPreference.Elements()) == 0 {
+		if !old.ExternalMetricsPreference.IsNull() && len(old.ExternalMetricsPreference.Elements()) > 0 {
+			preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameExternalMetricsPreference)
+		}
+	}
+
+	if new.InferredWorkloadTypes.IsNull() && !old.InferredWorkloadTypes.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameInferredWorkloadTypes)
+	}
+
+	if new.LookBackPeriod.IsNull() && !old.LookBackPeriod.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameLookbackPeriodPreference)
+	}
+
+	if new.UtilizationPreference.IsNull() || len(new.UtilizationPreference.Elements()) == 0 {
+		if !old.UtilizationPreference.IsNull() && len(old.UtilizationPreference.Elements()) > 0 {
+			preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameUtilizationPreferences)
+		}
+	}
+
+	if len(preferenceNames) > 0 {
+		_, err := conn.DeleteRecommendationPreferences(ctx, &computeoptimizer.DeleteRecommendationPreferencesInput{
+			RecommendationPreferenceNames: preferenceNames,
+			ResourceType:                  awstypes.ResourceType(new.ResourceType.ValueString()),
+			Scope:                         fwflex.ExpandFrameworkListNestedBlockPtr(ctx, new.Scope, expandScope),
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceRecommendationPreferences) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceRecommendationPreferencesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	tflog.Debug(ctx, "deleting Compute Optimizer Recommendation Preferences", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteRecommendationPreferences(ctx, &computeoptimizer.DeleteRecommendationPreferencesInput{
+		RecommendationPreferenceNames: awstypes.RecommendationPreferenceName("").Values(),
+		ResourceType:                  awstypes.ResourceType(data.ResourceType.ValueString()),
+		Scope:                         fwflex.ExpandFrameworkListNestedBlockPtr(ctx, data.Scope, expandScope),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceRecommendationPreferences) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if _, _, _, err := recommendationPreferencesParseResourceID(request.ID); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+type resourceRecommendationPreferencesData struct {
+	EnhancedInfrastructureMetrics types.String `tfsdk:"enhanced_infrastructure_metrics"`
+	ExternalMetricsPreference     types.List   `tfsdk:"external_metrics_preference"`
+	ID                            types.String `tfsdk:"id"`
+	InferredWorkloadTypes         types.String `tfsdk:"inferred_workload_types"`
+	LookBackPeriod                types.String `tfsdk:"look_back_period"`
+	ResourceType                  types.String `tfsdk:"resource_type"`
+	Scope                         types.List   `tfsdk:"scope"`
+	UtilizationPreference         types.List   `tfsdk:"utilization_preference"`
+}
+
+func (data *resourceRecommendationPreferencesData) putRecommendationPreferencesInput(ctx context.Context) *computeoptimizer.PutRecommendationPreferencesInput {
+	return &computeoptimizer.PutRecommendationPreferencesInput{
+		EnhancedInfrastructureMetrics: awstypes.EnhancedInfrastructureMetrics(data.EnhancedInfrastructureMetrics.ValueString()),
+		ExternalMetricsPreference:     fwflex.ExpandFrameworkListNestedBlockPtr(ctx, data.ExternalMetricsPreference, expandExternalMetricsPreference),
+		InferredWorkloadTypes:         awstypes.InferredWorkloadTypesPreference(data.InferredWorkloadTypes.ValueString()),
+		LookBackPeriod:                awstypes.LookBackPeriodPreference(data.LookBackPeriod.ValueString()),
+		ResourceType:                  awstypes.ResourceType(data.ResourceType.ValueString()),
+		Scope:                         fwflex.ExpandFrameworkListNestedBlockPtr(ctx, data.Scope, expandScope),
+		UtilizationPreferences:        fwflex.ExpandFrameworkListNestedBlock(ctx, data.UtilizationPreference, expandUtilizationPreference),
+	}
+}
+
+type externalMetricsPreferenceData struct {
+	Source types.String `tfsdk:"source"`
+}
+
+type scopeData struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type utilizationPreferenceData struct {
+	MetricName       types.String `tfsdk:"metric_name"`
+	MetricParameters types.List   `tfsdk:"metric_parameters"`
+}
+
+type customizableMetricParametersData struct {
+	Headroom  types.String `tfsdk:"headroom"`
+	Threshold types.String `tfsdk:"threshold"`
+}
+
+var (
+	externalMetricsPreferenceAttrTypes = map[string]attr.Type{
+		"source": types.StringType,
+	}
+
+	scopeAttrTypes = map[string]attr.Type{
+		"name":  types.StringType,
+		"value": types.StringType,
+	}
+
+	customizableMetricParametersAttrTypes = map[string]attr.Type{
+		"headroom":  types.StringType,
+		"threshold": types.StringType,
+	}
+
+	utilizationPreferenceAttrTypes = map[string]attr.Type{
+		"metric_name":       types.StringType,
+		"metric_parameters": types.ListType{ElemType: types.ObjectType{AttrTypes: customizableMetricParametersAttrTypes}},
+	}
+)
+
+func expandExternalMetricsPreference(ctx context.Context, tfObject externalMetricsPreferenceData) *awstypes.ExternalMetricsPreference {
+	return &awstypes.ExternalMetricsPreference{
+		Source: awstypes.ExternalMetricsSource(tfObject.Source.ValueString()),
+	}
+}
+
+func expandScope(ctx context.Context, tfObject scopeData) *awstypes.Scope {
+	return &awstypes.Scope{
+		Name:  awstypes.ScopeName(tfObject.Name.ValueString()),
+		Value: fwflex.StringFromFramework(ctx, tfObject.Value),
+	}
+}
+
+func expandUtilizationPreference(ctx context.Context, tfObject utilizationPreferenceData) awstypes.UtilizationPreference {
+	return awstypes.UtilizationPreference{
+		MetricName:       awstypes.CustomizableMetricName(tfObject.MetricName.ValueString()),
+		MetricParameters: fwflex.ExpandFrameworkListNestedBlockPtr(ctx, tfObject.MetricParameters, expandCustomizableMetricParameters),
+	}
+}
+
+func expandCustomizableMetricParameters(ctx context.Context, tfObject customizableMetricParametersData) *awstypes.CustomizableMetricParameters {
+	return &awstypes.CustomizableMetricParameters{
+		Headroom:  awstypes.CustomizableMetricHeadroom(tfObject.Headroom.ValueString()),
+		Threshold: awstypes.CustomizableMetricThreshold(tfObject.Threshold.ValueString()),
+	}
+}
+
+func flattenExternalMetricsPreference(ctx context.Context, apiObject *awstypes.ExternalMetricsPreference) types.List {
+	elemType := types.ObjectType{AttrTypes: externalMetricsPreferenceAttrTypes}
+
+	if apiObject == nil || apiObject.Source == "" {
+		return types.ListNull(elemType)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(externalMetricsPreferenceAttrTypes, map[string]attr.Value{
+			"source": fwflex.StringValueToFramework(ctx, apiObject.Source),
+		}),
+	})
+}
+
+func flattenScope(ctx context.Context, apiObject *awstypes.Scope) types.List {
+	elemType := types.ObjectType{AttrTypes: scopeAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(scopeAttrTypes, map[string]attr.Value{
+			"name":  fwflex.StringValueToFramework(ctx, apiObject.Name),
+			"value": fwflex.StringToFramework(ctx, apiObject.Value),
+		}),
+	})
+}
+
+func flattenUtilizationPreferences(ctx context.Context, apiObjects []awstypes.UtilizationPreference) types.List {
+	elemType := types.ObjectType{AttrTypes: utilizationPreferenceAttrTypes}
+
+	if len(apiObjects) == 0 {
+		return types.ListNull(elemType)
+	}
+
+	elems := make([]attr.Value, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		elems = append(elems, types.ObjectValueMust(utilizationPreferenceAttrTypes, map[string]attr.Value{
+			"metric_name":       fwflex.StringValueToFramework(ctx, apiObject.MetricName),
+			"metric_parameters": flattenCustomizableMetricParameters(ctx, apiObject.MetricParameters),
+		}))
+	}
+
+	return types.ListValueMust(elemType, elems)
+}
+
+func flattenCustomizableMetricParameters(ctx context.Context, apiObject *awstypes.CustomizableMetricParameters) types.List {
+	elemType := types.ObjectType{AttrTypes: customizableMetricParametersAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(customizableMetricParametersAttrTypes, map[string]attr.Value{
+			"headroom":  fwflex.StringValueToFramework(ctx, apiObject.Headroom),
+			"threshold": fwflex.StringValueToFramework(ctx, apiObject.Threshold),
+		}),
+	})
+}
+
+const recommendationPreferencesResourceIDPartCount = 3
+
+func recommendationPreferencesParseResourceID(id string) (string, string, string, error) {
+	parts, err := flex.ExpandResourceId(id, recommendationPreferencesResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func findRecommendationPreferencesByThreePartKey(ctx context.Context, conn *computeoptimizer.Client, resourceType, scopeName, scopeValue string) (*awstypes.RecommendationPreferencesDetail, error) {
+	input := &computeoptimizer.GetRecommendationPreferencesInput{
+		ResourceType: awstypes.ResourceType(resourceType),
+		Scope: &awstypes.Scope{
+			Name:  awstypes.ScopeName(scopeName),
+			Value: aws.String(scopeValue),
+		},
+	}
+
+	pages := computeoptimizer.NewGetRecommendationPreferencesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RecommendationPreferencesDetails {
+			v := v
+
+			if v.Scope != nil && string(v.Scope.Name) == scopeName && aws.ToString(v.Scope.Value) == scopeValue {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
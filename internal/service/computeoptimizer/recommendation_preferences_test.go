@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheckEnrolled(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(string(awstypes.EnhancedInfrastructureMetricsActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", string(awstypes.EnhancedInfrastructureMetricsActive)),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "inferred_workload_types"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", string(awstypes.ResourceTypeEc2Instance)),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.name", string(awstypes.ScopeNameAccountId)),
+					acctest.CheckResourceAttrAccountID(resourceName, "scope.0.value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheckEnrolled(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(string(awstypes.EnhancedInfrastructureMetricsActive)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcomputeoptimizer.ResourceRecommendationPreferences, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheckEnrolled(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(string(awstypes.EnhancedInfrastructureMetricsActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", string(awstypes.EnhancedInfrastructureMetricsActive)),
+					resource.TestCheckNoResourceAttr(resourceName, "inferred_workload_types"),
+				),
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_inferredWorkloadTypes(string(awstypes.InferredWorkloadTypesPreferenceActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "enhanced_infrastructure_metrics"),
+					resource.TestCheckResourceAttr(resourceName, "inferred_workload_types", string(awstypes.InferredWorkloadTypesPreferenceActive)),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_externalMetricsPreference(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheckEnrolled(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_externalMetricsPreference(string(awstypes.ExternalMetricsSourceDatadog)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", string(awstypes.ExternalMetricsSourceDatadog)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_externalMetricsPreference(string(awstypes.ExternalMetricsSourceDynatrace)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", string(awstypes.ExternalMetricsSourceDynatrace)),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_lookBackPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheckEnrolled(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_lookBackPeriod(string(awstypes.LookBackPeriodPreferenceDays32)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "look_back_period", string(awstypes.LookBackPeriodPreferenceDays32)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_lookBackPeriod(string(awstypes.LookBackPeriodPreferenceDays93)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "look_back_period", string(awstypes.LookBackPeriodPreferenceDays93)),
+				),
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(string(awstypes.EnhancedInfrastructureMetricsActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "look_back_period"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_utilizationPreference(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheckEnrolled(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_utilizationPreference(string(awstypes.CustomizableMetricHeadroomPercent20), string(awstypes.CustomizableMetricThresholdP95)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_name", string(awstypes.CustomizableMetricNameCpuUtilization)),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.headroom", string(awstypes.CustomizableMetricHeadroomPercent20)),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.threshold", string(awstypes.CustomizableMetricThresholdP95)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_utilizationPreference(string(awstypes.CustomizableMetricHeadroomPercent0), string(awstypes.CustomizableMetricThresholdP995)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.headroom", string(awstypes.CustomizableMetricHeadroomPercent0)),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.threshold", string(awstypes.CustomizableMetricThresholdP995)),
+				),
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(string(awstypes.EnhancedInfrastructureMetricsActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_recommendation_preferences" {
+				continue
+			}
+
+			resourceType, scopeName, scopeValue, err := tfcomputeoptimizer.RecommendationPreferencesParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			output, err := tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, resourceType, scopeName, scopeValue)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Deleted preferences revert to their defaults.
+			if output.EnhancedInfrastructureMetrics != awstypes.EnhancedInfrastructureMetricsActive &&
+				output.ExternalMetricsPreference == nil &&
+				output.InferredWorkloadTypes != awstypes.InferredWorkloadTypesPreferenceActive &&
+				(output.LookBackPeriod == "" || output.LookBackPeriod == awstypes.LookBackPeriodPreferenceDays14) &&
+				len(output.UtilizationPreferences) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("Compute Optimizer Recommendation Preferences %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecommendationPreferencesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		resourceType, scopeName, scopeValue, err := tfcomputeoptimizer.RecommendationPreferencesParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		_, err = tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, resourceType, scopeName, scopeValue)
+
+		return err
+	}
+}
+
+func testAccRecommendationPreferencesConfig_basic(enhancedInfrastructureMetrics string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type                   = "Ec2Instance"
+  enhanced_infrastructure_metrics = %[1]q
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+}
+`, enhancedInfrastructureMetrics)
+}
+
+func testAccRecommendationPreferencesConfig_inferredWorkloadTypes(inferredWorkloadTypes string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type           = "Ec2Instance"
+  inferred_workload_types = %[1]q
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+}
+`, inferredWorkloadTypes)
+}
+
+func testAccRecommendationPreferencesConfig_externalMetricsPreference(source string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  external_metrics_preference {
+    source = %[1]q
+  }
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+}
+`, source)
+}
+
+func testAccRecommendationPreferencesConfig_lookBackPeriod(lookBackPeriod string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type    = "Ec2Instance"
+  look_back_period = %[1]q
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+}
+`, lookBackPeriod)
+}
+
+func testAccRecommendationPreferencesConfig_utilizationPreference(headroom, threshold string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  utilization_preference {
+    metric_name = "CpuUtilization"
+
+    metric_parameters {
+      headroom  = %[1]q
+      threshold = %[2]q
+    }
+  }
+}
+`, headroom, threshold)
+}
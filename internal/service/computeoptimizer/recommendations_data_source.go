@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource
+func newDataSourceRecommendations(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceRecommendations{}, nil
+}
+
+type dataSourceRecommendations struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceRecommendations) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_recommendations"
+}
+
+func (d *dataSourceRecommendations) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ebs_volume_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"ebs_volume_recommendations": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: volumeRecommendationAttrTypes},
+				Computed:    true,
+			},
+			"ec2_instance_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"ec2_instance_recommendations": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: instanceRecommendationAttrTypes},
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"lambda_function_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"lambda_function_recommendations": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: lambdaFunctionRecommendationAttrTypes},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceRecommendations) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceRecommendationsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ComputeOptimizerClient(ctx)
+
+	// Recommendations are only retrieved for the resource types that are configured.
+	// An empty set of ARNs returns recommendations for all resources of that type.
+	data.EBSVolumeRecommendations = types.ListNull(types.ObjectType{AttrTypes: volumeRecommendationAttrTypes})
+	if !data.EBSVolumeARNs.IsNull() {
+		input := &computeoptimizer.GetEBSVolumeRecommendationsInput{
+			VolumeArns: flex.ExpandFrameworkStringValueSet(ctx, data.EBSVolumeARNs),
+		}
+
+		output, err := findEBSVolumeRecommendations(ctx, conn, input)
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Compute Optimizer EBS volume recommendations", err.Error())
+
+			return
+		}
+
+		data.EBSVolumeRecommendations = flattenVolumeRecommendations(ctx, output)
+	}
+
+	data.EC2InstanceRecommendations = types.ListNull(types.ObjectType{AttrTypes: instanceRecommendationAttrTypes})
+	if !data.EC2InstanceARNs.IsNull() {
+		input := &computeoptimizer.GetEC2InstanceRecommendationsInput{
+			InstanceArns: flex.ExpandFrameworkStringValueSet(ctx, data.EC2InstanceARNs),
+		}
+
+		output, err := findEC2InstanceRecommendations(ctx, conn, input)
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Compute Optimizer EC2 instance recommendations", err.Error())
+
+			return
+		}
+
+		data.EC2InstanceRecommendations = flattenInstanceRecommendations(ctx, output)
+	}
+
+	data.LambdaFunctionRecommendations = types.ListNull(types.ObjectType{AttrTypes: lambdaFunctionRecommendationAttrTypes})
+	if !data.LambdaFunctionARNs.IsNull() {
+		input := &computeoptimizer.GetLambdaFunctionRecommendationsInput{
+			FunctionArns: flex.ExpandFrameworkStringValueSet(ctx, data.LambdaFunctionARNs),
+		}
+
+		output, err := findLambdaFunctionRecommendations(ctx, conn, input)
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Compute Optimizer Lambda function recommendations", err.Error())
+
+			return
+		}
+
+		data.LambdaFunctionRecommendations = flattenLambdaFunctionRecommendations(ctx, output)
+	}
+
+	data.ID = types.StringValue(d.Meta().AccountID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceRecommendationsData struct {
+	EBSVolumeARNs                 types.Set    `tfsdk:"ebs_volume_arns"`
+	EBSVolumeRecommendations      types.List   `tfsdk:"ebs_volume_recommendations"`
+	EC2InstanceARNs               types.Set    `tfsdk:"ec2_instance_arns"`
+	EC2InstanceRecommendations    types.List   `tfsdk:"ec2_instance_recommendations"`
+	ID                            types.String `tfsdk:"id"`
+	LambdaFunctionARNs            types.Set    `tfsdk:"lambda_function_arns"`
+	LambdaFunctionRecommendations types.List   `tfsdk:"lambda_function_recommendations"`
+}
+
+var (
+	instanceRecommendationOptionAttrTypes = map[string]attr.Type{
+		"instance_type":    types.StringType,
+		"migration_effort": types.StringType,
+		"performance_risk": types.Float64Type,
+		"rank":             types.Int64Type,
+	}
+
+	instanceRecommendationAttrTypes = map[string]attr.Type{
+		"current_instance_type":     types.StringType,
+		"finding":                   types.StringType,
+		"instance_arn":              types.StringType,
+		"instance_name":             types.StringType,
+		"look_back_period_in_days":  types.Float64Type,
+		"recommendation_options":    types.ListType{ElemType: types.ObjectType{AttrTypes: instanceRecommendationOptionAttrTypes}},
+		"recommended_instance_type": types.StringType,
+	}
+
+	lambdaFunctionRecommendationOptionAttrTypes = map[string]attr.Type{
+		"memory_size": types.Int64Type,
+		"rank":        types.Int64Type,
+	}
+
+	lambdaFunctionRecommendationAttrTypes = map[string]attr.Type{
+		"current_memory_size":      types.Int64Type,
+		"finding":                  types.StringType,
+		"function_arn":             types.StringType,
+		"function_version":         types.StringType,
+		"look_back_period_in_days": types.Float64Type,
+		"recommendation_options":   types.ListType{ElemType: types.ObjectType{AttrTypes: lambdaFunctionRecommendationOptionAttrTypes}},
+		"recommended_memory_size":  types.Int64Type,
+	}
+
+	volumeConfigurationAttrTypes = map[string]attr.Type{
+		"volume_baseline_iops":       types.Int64Type,
+		"volume_baseline_throughput": types.Int64Type,
+		"volume_size":                types.Int64Type,
+		"volume_type":                types.StringType,
+	}
+
+	volumeRecommendationOptionAttrTypes = map[string]attr.Type{
+		"configuration":    types.ListType{ElemType: types.ObjectType{AttrTypes: volumeConfigurationAttrTypes}},
+		"performance_risk": types.Float64Type,
+		"rank":             types.Int64Type,
+	}
+
+	volumeRecommendationAttrTypes = map[string]attr.Type{
+		"current_configuration":    types.ListType{ElemType: types.ObjectType{AttrTypes: volumeConfigurationAttrTypes}},
+		"finding":                  types.StringType,
+		"look_back_period_in_days": types.Float64Type,
+		"recommendation_options":   types.ListType{ElemType: types.ObjectType{AttrTypes: volumeRecommendationOptionAttrTypes}},
+		"volume_arn":               types.StringType,
+	}
+)
+
+func findEBSVolumeRecommendations(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetEBSVolumeRecommendationsInput) ([]awstypes.VolumeRecommendation, error) {
+	var output []awstypes.VolumeRecommendation
+
+	for {
+		page, err := conn.GetEBSVolumeRecommendations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.VolumeRecommendations...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func findEC2InstanceRecommendations(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetEC2InstanceRecommendationsInput) ([]awstypes.InstanceRecommendation, error) {
+	var output []awstypes.InstanceRecommendation
+
+	for {
+		page, err := conn.GetEC2InstanceRecommendations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.InstanceRecommendations...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func findLambdaFunctionRecommendations(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetLambdaFunctionRecommendationsInput) ([]awstypes.LambdaFunctionRecommendation, error) {
+	var output []awstypes.LambdaFunctionRecommendation
+
+	pages := computeoptimizer.NewGetLambdaFunctionRecommendationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.LambdaFunctionRecommendations...)
+	}
+
+	return output, nil
+}
+
+func flattenInstanceRecommendations(ctx context.Context, apiObjects []awstypes.InstanceRecommendation) types.List {
+	elemType := types.ObjectType{AttrTypes: instanceRecommendationAttrTypes}
+	optionElemType := types.ObjectType{AttrTypes: instanceRecommendationOptionAttrTypes}
+	elems := []attr.Value{}
+
+	for _, apiObject := range apiObjects {
+		var recommendedInstanceType *string
+		options := []attr.Value{}
+
+		for _, option := range apiObject.RecommendationOptions {
+			// Options are ranked from 1 (best) upwards.
+			if option.Rank == 1 {
+				recommendedInstanceType = option.InstanceType
+			}
+
+			options = append(options, types.ObjectValueMust(instanceRecommendationOptionAttrTypes, map[string]attr.Value{
+				"instance_type":    flex.StringToFramework(ctx, option.InstanceType),
+				"migration_effort": flex.StringValueToFramework(ctx, option.MigrationEffort),
+				"performance_risk": types.Float64Value(option.PerformanceRisk),
+				"rank":             types.Int64Value(int64(option.Rank)),
+			}))
+		}
+
+		elems = append(elems, types.ObjectValueMust(instanceRecommendationAttrTypes, map[string]attr.Value{
+			"current_instance_type":     flex.StringToFramework(ctx, apiObject.CurrentInstanceType),
+			"finding":                   flex.StringValueToFramework(ctx, apiObject.Finding),
+			"instance_arn":              flex.StringToFramework(ctx, apiObject.InstanceArn),
+			"instance_name":             flex.StringToFramework(ctx, apiObject.InstanceName),
+			"look_back_period_in_days":  types.Float64Value(apiObject.LookBackPeriodInDays),
+			"recommendation_options":    types.ListValueMust(optionElemType, options),
+			"recommended_instance_type": flex.StringToFramework(ctx, recommendedInstanceType),
+		}))
+	}
+
+	return types.ListValueMust(elemType, elems)
+}
+
+func flattenLambdaFunctionRecommendations(ctx context.Context, apiObjects []awstypes.LambdaFunctionRecommendation) types.List {
+	elemType := types.ObjectType{AttrTypes: lambdaFunctionRecommendationAttrTypes}
+	optionElemType := types.ObjectType{AttrTypes: lambdaFunctionRecommendationOptionAttrTypes}
+	elems := []attr.Value{}
+
+	for _, apiObject := range apiObjects {
+		recommendedMemorySize := types.Int64Null()
+		options := []attr.Value{}
+
+		for _, option := range apiObject.MemorySizeRecommendationOptions {
+			if option.Rank == 1 {
+				recommendedMemorySize = types.Int64Value(int64(option.MemorySize))
+			}
+
+			options = append(options, types.ObjectValueMust(lambdaFunctionRecommendationOptionAttrTypes, map[string]attr.Value{
+				"memory_size": types.Int64Value(int64(option.MemorySize)),
+				"rank":        types.Int64Value(int64(option.Rank)),
+			}))
+		}
+
+		elems = append(elems, types.ObjectValueMust(lambdaFunctionRecommendationAttrTypes, map[string]attr.Value{
+			"current_memory_size":      types.Int64Value(int64(apiObject.CurrentMemorySize)),
+			"finding":                  flex.StringValueToFramework(ctx, apiObject.Finding),
+			"function_arn":             flex.StringToFramework(ctx, apiObject.FunctionArn),
+			"function_version":         flex.StringToFramework(ctx, apiObject.FunctionVersion),
+			"look_back_period_in_days": types.Float64Value(apiObject.LookbackPeriodInDays),
+			"recommendation_options":   types.ListValueMust(optionElemType, options),
+			"recommended_memory_size":  recommendedMemorySize,
+		}))
+	}
+
+	return types.ListValueMust(elemType, elems)
+}
+
+func flattenVolumeRecommendations(ctx context.Context, apiObjects []awstypes.VolumeRecommendation) types.List {
+	elemType := types.ObjectType{AttrTypes: volumeRecommendationAttrTypes}
+	optionElemType := types.ObjectType{AttrTypes: volumeRecommendationOptionAttrTypes}
+	elems := []attr.Value{}
+
+	for _, apiObject := range apiObjects {
+		options := []attr.Value{}
+
+		for _, option := range apiObject.VolumeRecommendationOptions {
+			options = append(options, types.ObjectValueMust(volumeRecommendationOptionAttrTypes, map[string]attr.Value{
+				"configuration":    flattenVolumeConfiguration(ctx, option.Configuration),
+				"performance_risk": types.Float64Value(option.PerformanceRisk),
+				"rank":             types.Int64Value(int64(option.Rank)),
+			}))
+		}
+
+		elems = append(elems, types.ObjectValueMust(volumeRecommendationAttrTypes, map[string]attr.Value{
+			"current_configuration":    flattenVolumeConfiguration(ctx, apiObject.CurrentConfiguration),
+			"finding":                  flex.StringValueToFramework(ctx, apiObject.Finding),
+			"look_back_period_in_days": types.Float64Value(apiObject.LookBackPeriodInDays),
+			"recommendation_options":   types.ListValueMust(optionElemType, options),
+			"volume_arn":               flex.StringToFramework(ctx, apiObject.VolumeArn),
+		}))
+	}
+
+	return types.ListValueMust(elemType, elems)
+}
+
+func flattenVolumeConfiguration(ctx context.Context, apiObject *awstypes.VolumeConfiguration) types.List {
+	elemType := types.ObjectType{AttrTypes: volumeConfigurationAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(volumeConfigurationAttrTypes, map[string]attr.Value{
+			"volume_baseline_iops":       types.Int64Value(int64(apiObject.VolumeBaselineIOPS)),
+			"volume_baseline_throughput": types.Int64Value(int64(apiObject.VolumeBaselineThroughput)),
+			"volume_size":                types.Int64Value(int64(apiObject.VolumeSize)),
+			"volume_type":                flex.StringToFramework(ctx, apiObject.VolumeType),
+		}),
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_computeoptimizer_recommendations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheckEnrolled(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ebs_volume_recommendations.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ec2_instance_recommendations.#"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "lambda_function_recommendations.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRecommendationsDataSourceConfig_basic = `
+data "aws_computeoptimizer_recommendations" "test" {
+  ebs_volume_arns   = []
+  ec2_instance_arns = []
+}
+`
@@ -15,11 +15,33 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceRecommendations,
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceEnrollmentStatus,
+			Name:    "Enrollment Status",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
+		},
+		{
+			Factory: newResourceRecommendationPreferences,
+			Name:    "Recommendation Preferences",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -24,6 +24,7 @@ import (
 
 // @SDKResource("aws_config_aggregate_authorization", name="Aggregate Authorization")
 // @Tags(identifierAttribute="arn")
+// @Region(overrideEnabled=false)
 func ResourceAggregateAuthorization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAggregateAuthorizationPut,
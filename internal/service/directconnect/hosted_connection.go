@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_dx_hosted_connection")
+// @Region(overrideEnabled=false)
 func ResourceHostedConnection() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostedConnectionCreate,
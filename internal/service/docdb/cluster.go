@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -40,6 +41,10 @@ func ResourceCluster() *schema.Resource {
 			StateContext: resourceClusterImport,
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("master_password"), cty.GetAttrPath("master_password_wo")),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Update: schema.DefaultTimeout(120 * time.Minute),
@@ -173,9 +178,24 @@ func ResourceCluster() *schema.Resource {
 			},
 
 			"master_password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"master_password_wo"},
+			},
+
+			"master_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"master_password"},
+			},
+
+			"master_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"master_password_wo"},
 			},
 
 			"snapshot_identifier": {
@@ -298,6 +318,12 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		ApplyImmediately: aws.Bool(true),
 	}
 
+	masterPasswordWO, ds := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
+	diags = append(diags, ds...)
+	if diags.HasError() {
+		return diags
+	}
+
 	var identifier string
 	if v, ok := d.GetOk("cluster_identifier"); ok {
 		identifier = v.(string)
@@ -383,7 +409,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	} else {
 		// Secondary DocDB clusters part of a global cluster will not supply the master_password
 		if _, ok := d.GetOk("global_cluster_identifier"); !ok {
-			if _, ok := d.GetOk("master_password"); !ok {
+			if _, ok := d.GetOk("master_password"); !ok && masterPasswordWO == "" {
 				return sdkdiag.AppendErrorf(diags, `provider.aws: aws_docdb_cluster: %s: "master_password": required field is not set`, identifier)
 			}
 		}
@@ -404,6 +430,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			Tags:                getTagsIn(ctx),
 		}
 
+		if masterPasswordWO != "" {
+			createOpts.MasterUserPassword = aws.String(masterPasswordWO)
+		}
+
 		if attr, ok := d.GetOk("global_cluster_identifier"); ok {
 			createOpts.GlobalClusterIdentifier = aws.String(attr.(string))
 		}
@@ -632,6 +662,19 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		requestUpdate = true
 	}
 
+	if d.HasChange("master_password_wo_version") {
+		masterPasswordWO, ds := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
+		diags = append(diags, ds...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if masterPasswordWO != "" {
+			req.MasterUserPassword = aws.String(masterPasswordWO)
+			requestUpdate = true
+		}
+	}
+
 	if d.HasChange("engine_version") {
 		req.EngineVersion = aws.String(d.Get("engine_version").(string))
 		requestUpdate = true
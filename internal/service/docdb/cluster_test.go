@@ -18,6 +18,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
@@ -79,6 +80,42 @@ func TestAccDocDBCluster_basic(t *testing.T) {
 	})
 }
 
+func TestAccDocDBCluster_masterPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster docdb.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, docdb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "mustbeeightcharaters", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password"),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "mustbeeightcharaterstoo", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBCluster_namePrefix(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v docdb.DBCluster
@@ -992,6 +1029,19 @@ resource "aws_docdb_cluster" "default" {
 `, n))
 }
 
+func testAccClusterConfig_masterPasswordWriteOnly(rName, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier         = %[1]q
+  master_username            = "foo"
+  master_password_wo         = %[2]q
+  master_password_wo_version = %[3]d
+  skip_final_snapshot        = true
+  apply_immediately          = true
+}
+`, rName, password, passwordVersion)
+}
+
 func testAccClusterConfig_namePrefix() string {
 	return `
 resource "aws_docdb_cluster" "test" {
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+// Exports for use in tests only.
+var (
+	FindClusterByARN = findClusterByARN
+	ResourceCluster  = newResourceCluster
+)
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_availability_zone")
+// @Region(overrideEnabled=false)
 func DataSourceAvailabilityZone() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAvailabilityZoneRead,
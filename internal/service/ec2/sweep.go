@@ -7,6 +7,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -18,11 +19,17 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
+	// Resources of these types that are swept together, e.g. by the VPC sweeper, are deleted in dependency order.
+	sweep.AddDependencies("aws_network_interface", "aws_security_group", "aws_subnet", "aws_vpc")
+	sweep.AddDependencies("aws_security_group", "aws_vpc")
+	sweep.AddDependencies("aws_subnet", "aws_vpc")
+
 	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
@@ -1274,7 +1281,7 @@ func sweepNetworkInterfaces(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewTypedSweepResource("aws_network_interface", r, d, client))
 		}
 
 		return !lastPage
@@ -1717,7 +1724,7 @@ func sweepSubnets(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.SubnetId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewTypedSweepResource("aws_subnet", r, d, client))
 		}
 
 		return !lastPage
@@ -2249,6 +2256,7 @@ func sweepVPCs(region string) error {
 	conn := client.EC2Conn(ctx)
 	input := &ec2.DescribeVpcsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
+	vpcIDs := make(map[string]struct{})
 
 	err = conn.DescribeVpcsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
 		if page == nil {
@@ -2261,11 +2269,14 @@ func sweepVPCs(region string) error {
 				continue
 			}
 
+			id := aws.StringValue(v.VpcId)
+			vpcIDs[id] = struct{}{}
+
 			r := ResourceVPC()
 			d := r.Data(nil)
-			d.SetId(aws.StringValue(v.VpcId))
+			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewTypedSweepResource("aws_vpc", r, d, client))
 		}
 
 		return !lastPage
@@ -2280,6 +2291,16 @@ func sweepVPCs(region string) error {
 		return fmt.Errorf("error listing EC2 VPCs (%s): %w", region, err)
 	}
 
+	// Sweep any of the VPCs' Subnets, Security Groups and Network Interfaces left behind by their own sweepers
+	// together with the VPCs, so that they are deleted in dependency order instead of blocking VPC deletion.
+	dependents, err := vpcDependentSweepResources(ctx, client, vpcIDs)
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 VPC dependencies (%s): %w", region, err)
+	}
+
+	sweepResources = append(sweepResources, dependents...)
+
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
@@ -2289,6 +2310,97 @@ func sweepVPCs(region string) error {
 	return nil
 }
 
+// vpcDependentSweepResources returns sweepables for the non-default Subnets, non-default Security Groups
+// and available Network Interfaces in the specified VPCs.
+func vpcDependentSweepResources(ctx context.Context, client *conns.AWSClient, vpcIDs map[string]struct{}) ([]sweep.Sweepable, error) {
+	conn := client.EC2Conn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	if len(vpcIDs) == 0 {
+		return sweepResources, nil
+	}
+
+	inVPCs := func(v *string) bool {
+		_, ok := vpcIDs[aws.StringValue(v)]
+		return ok
+	}
+
+	err := conn.DescribeSubnetsPagesWithContext(ctx, &ec2.DescribeSubnetsInput{}, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Subnets {
+			if aws.BoolValue(v.DefaultForAz) || !inVPCs(v.VpcId) {
+				continue
+			}
+
+			r := ResourceSubnet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SubnetId))
+
+			sweepResources = append(sweepResources, sweep.NewTypedSweepResource("aws_subnet", r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("listing EC2 Subnets: %w", err)
+	}
+
+	err = conn.DescribeSecurityGroupsPagesWithContext(ctx, &ec2.DescribeSecurityGroupsInput{}, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroups {
+			if aws.StringValue(v.GroupName) == "default" || !inVPCs(v.VpcId) {
+				continue
+			}
+
+			r := ResourceSecurityGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.GroupId))
+			d.Set("revoke_rules_on_delete", true)
+
+			sweepResources = append(sweepResources, sweep.NewTypedSweepResource("aws_security_group", r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("listing EC2 Security Groups: %w", err)
+	}
+
+	err = conn.DescribeNetworkInterfacesPagesWithContext(ctx, &ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInterfaces {
+			if aws.StringValue(v.Status) != ec2.NetworkInterfaceStatusAvailable || !inVPCs(v.VpcId) {
+				continue
+			}
+
+			r := ResourceNetworkInterface()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.NetworkInterfaceId))
+
+			sweepResources = append(sweepResources, sweep.NewTypedSweepResource("aws_network_interface", r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("listing EC2 Network Interfaces: %w", err)
+	}
+
+	return sweepResources, nil
+}
+
 func sweepVPNConnections(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
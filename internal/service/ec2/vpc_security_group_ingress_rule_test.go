@@ -121,6 +121,36 @@ func TestAccVPCSecurityGroupIngressRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroupIngressRule_regionOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig_region(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARNRegion(resourceName, "arn", "ec2", acctest.AlternateRegion(), regexp.MustCompile(`security-group-rule/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSecurityGroupRuleImportStateIDFuncWithRegion(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroupIngressRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.SecurityGroupRule
@@ -1008,13 +1038,13 @@ func testAccCheckSecurityGroupRuleRecreated(i, j *ec2.SecurityGroupRule) resourc
 
 func testAccCheckSecurityGroupIngressRuleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
-
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_vpc_security_group_ingress_rule" {
 				continue
 			}
 
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ForRegion(rs.Primary.Attributes["region"]).EC2Conn(ctx)
+
 			_, err := tfec2.FindSecurityGroupIngressRuleByID(ctx, conn, rs.Primary.ID)
 
 			if tfresource.NotFound(err) {
@@ -1043,7 +1073,7 @@ func testAccCheckSecurityGroupIngressRuleExists(ctx context.Context, n string, v
 			return fmt.Errorf("No VPC Security Group Ingress Rule ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForRegion(rs.Primary.Attributes["region"]).EC2Conn(ctx)
 
 		output, err := tfec2.FindSecurityGroupIngressRuleByID(ctx, conn, rs.Primary.ID)
 
@@ -1065,6 +1095,17 @@ func testAccCheckSecurityGroupIngressRuleUpdateTags(ctx context.Context, v *ec2.
 	}
 }
 
+func testAccSecurityGroupRuleImportStateIDFuncWithRegion(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.ID + "@" + rs.Primary.Attributes["region"], nil
+	}
+}
+
 func testAccVPCSecurityGroupRuleConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -1099,6 +1140,42 @@ resource "aws_vpc_security_group_ingress_rule" "test" {
 `)
 }
 
+func testAccVPCSecurityGroupIngressRuleConfig_region(rName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  region = %[2]q
+
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  region = %[2]q
+
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  region = %[2]q
+
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+`, rName, region)
+}
+
 func testAccVPCSecurityGroupIngressRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
 resource "aws_vpc_security_group_ingress_rule" "test" {
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @EphemeralResource(name="Authorization Token")
+func newEphemeralAuthorizationToken(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthorizationToken) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ecr_authorization_token"
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"registry_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"user_name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralAuthorizationTokenData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().ECRConn(ctx)
+
+	input := &ecr.GetAuthorizationTokenInput{}
+
+	if !data.RegistryID.IsNull() {
+		input.RegistryIds = aws.StringSlice([]string{data.RegistryID.ValueString()})
+	}
+
+	output, err := conn.GetAuthorizationTokenWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("getting ECR authorization token", err.Error())
+
+		return
+	}
+
+	if output == nil || len(output.AuthorizationData) == 0 || output.AuthorizationData[0] == nil {
+		response.Diagnostics.AddError("getting ECR authorization token", "empty result")
+
+		return
+	}
+
+	authorizationData := output.AuthorizationData[0]
+	authorizationToken := aws.StringValue(authorizationData.AuthorizationToken)
+	authBytes, err := base64.URLEncoding.DecodeString(authorizationToken)
+
+	if err != nil {
+		response.Diagnostics.AddError("decoding ECR authorization token", err.Error())
+
+		return
+	}
+
+	userName, password, ok := strings.Cut(string(authBytes), ":")
+
+	if !ok {
+		response.Diagnostics.AddError("decoding ECR authorization token", "unknown ECR authorization token format")
+
+		return
+	}
+
+	data.AuthorizationToken = types.StringValue(authorizationToken)
+	data.ExpiresAt = types.StringValue(aws.TimeValue(authorizationData.ExpiresAt).Format(time.RFC3339))
+	data.Password = types.StringValue(password)
+	data.ProxyEndpoint = flex.StringToFramework(ctx, authorizationData.ProxyEndpoint)
+	data.UserName = types.StringValue(userName)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralAuthorizationTokenData struct {
+	AuthorizationToken types.String `tfsdk:"authorization_token"`
+	ExpiresAt          types.String `tfsdk:"expires_at"`
+	Password           types.String `tfsdk:"password"`
+	ProxyEndpoint      types.String `tfsdk:"proxy_endpoint"`
+	RegistryID         types.String `tfsdk:"registry_id"`
+	UserName           types.String `tfsdk:"user_name"`
+}
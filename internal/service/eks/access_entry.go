@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_eks_access_entry", name="Access Entry")
+// @Tags(identifierAttribute="access_entry_arn")
+func ResourceAccessEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessEntryCreate,
+		ReadWithoutTimeout:   resourceAccessEntryRead,
+		UpdateWithoutTimeout: resourceAccessEntryUpdate,
+		DeleteWithoutTimeout: resourceAccessEntryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_entry_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kubernetes_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      AccessEntryTypeStandard,
+				ValidateFunc: validation.StringInSlice(AccessEntryType_Values(), false),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	principalARN := d.Get("principal_arn").(string)
+	entryID := AccessEntryCreateResourceID(clusterName, principalARN)
+	input := &eks.CreateAccessEntryInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+		PrincipalArn:       aws.String(principalARN),
+		Tags:               getTagsIn(ctx),
+		Type:               aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("kubernetes_groups"); ok && v.(*schema.Set).Len() > 0 {
+		input.KubernetesGroups = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("user_name"); ok {
+		input.Username = aws.String(v.(string))
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateAccessEntryWithContext(ctx, input)
+	}, eks.ErrCodeInvalidParameterException, "does not exist")
+
+	if err != nil {
+		return diag.Errorf("creating EKS Access Entry (%s): %s", entryID, err)
+	}
+
+	d.SetId(entryID)
+
+	return resourceAccessEntryRead(ctx, d, meta)
+}
+
+func resourceAccessEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Access Entry (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EKS Access Entry (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_entry_arn", output.AccessEntryArn)
+	d.Set("cluster_name", output.ClusterName)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("kubernetes_groups", aws.StringValueSlice(output.KubernetesGroups))
+	d.Set("modified_at", aws.TimeValue(output.ModifiedAt).Format(time.RFC3339))
+	d.Set("principal_arn", output.PrincipalArn)
+	d.Set("type", output.Type)
+	d.Set("user_name", output.Username)
+
+	setTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceAccessEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &eks.UpdateAccessEntryInput{
+			ClientRequestToken: aws.String(id.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			PrincipalArn:       aws.String(principalARN),
+		}
+
+		if d.HasChange("kubernetes_groups") {
+			input.KubernetesGroups = flex.ExpandStringSet(d.Get("kubernetes_groups").(*schema.Set))
+		}
+
+		if d.HasChange("user_name") {
+			input.Username = aws.String(d.Get("user_name").(string))
+		}
+
+		_, err = conn.UpdateAccessEntryWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Access Entry (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAccessEntryRead(ctx, d, meta)
+}
+
+func resourceAccessEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Access Entry: %s", d.Id())
+	_, err = conn.DeleteAccessEntryWithContext(ctx, &eks.DeleteAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EKS Access Entry (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindAccessEntryByTwoPartKey(ctx context.Context, conn *eks.EKS, clusterName, principalARN string) (*eks.AccessEntry, error) {
+	input := &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	output, err := conn.DescribeAccessEntryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessEntry == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccessEntry, nil
+}
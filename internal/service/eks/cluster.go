@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -40,6 +42,8 @@ func ResourceCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			validateAuthenticationModeChange,
+			forceNewIfBootstrapClusterCreatorAdminPermissionsChange,
 			customdiff.ForceNewIfChange("encryption_config", func(_ context.Context, old, new, meta interface{}) bool {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
@@ -53,6 +57,27 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(eks.AuthenticationMode_Values(), false),
+						},
+						"bootstrap_cluster_creator_admin_permissions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -304,6 +329,17 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		Tags:               getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("access_config"); ok {
+		input.AccessConfig = expandCreateAccessConfigRequest(v.([]interface{}))
+
+		// Only send bootstrap_cluster_creator_admin_permissions when it is configured so that the API default applies otherwise.
+		if v := d.GetRawConfig().GetAttr("access_config"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			if v := v.Index(cty.NumberIntVal(0)).GetAttr("bootstrap_cluster_creator_admin_permissions"); v.IsKnown() && !v.IsNull() {
+				input.AccessConfig.BootstrapClusterCreatorAdminPermissions = aws.Bool(v.True())
+			}
+		}
+	}
+
 	if _, ok := d.GetOk("kubernetes_network_config"); ok {
 		input.KubernetesNetworkConfig = expandKubernetesNetworkConfigRequest(d.Get("kubernetes_network_config").([]interface{}))
 	}
@@ -377,6 +413,11 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("reading EKS Cluster (%s): %s", d.Id(), err)
 	}
 
+	// Clusters created before access entries were available don't report whether the creator was granted admin permissions.
+	bootstrapClusterCreatorAdminPermissions := d.Get("access_config.0.bootstrap_cluster_creator_admin_permissions").(bool)
+	if err := d.Set("access_config", flattenAccessConfigResponse(cluster.AccessConfig, bootstrapClusterCreatorAdminPermissions)); err != nil {
+		return diag.Errorf("setting access_config: %s", err)
+	}
 	d.Set("arn", cluster.Arn)
 	if err := d.Set("certificate_authority", flattenCertificate(cluster.CertificateAuthority)); err != nil {
 		return diag.Errorf("setting certificate_authority: %s", err)
@@ -441,6 +482,30 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	// Authentication mode changes are one-way, so make them before anything else that may fail.
+	if d.HasChange("access_config.0.authentication_mode") {
+		input := &eks.UpdateClusterConfigInput{
+			AccessConfig: &eks.UpdateAccessConfigRequest{
+				AuthenticationMode: aws.String(d.Get("access_config.0.authentication_mode").(string)),
+			},
+			Name: aws.String(d.Id()),
+		}
+
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Cluster (%s) access configuration: %s", d.Id(), err)
+		}
+
+		updateID := aws.StringValue(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EKS Cluster (%s) access configuration update (%s): %s", d.Id(), updateID, err)
+		}
+	}
+
 	if d.HasChange("encryption_config") {
 		o, n := d.GetChange("encryption_config")
 
@@ -703,6 +768,78 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id st
 	return nil, err
 }
 
+// authenticationModeOrder is the order in which a cluster's authentication mode may change.
+// Once access entries are enabled they can't be disabled, and once the aws-auth ConfigMap
+// is no longer used it can't be used again.
+var authenticationModeOrder = map[string]int{
+	eks.AuthenticationModeConfigMap:       0,
+	eks.AuthenticationModeApiAndConfigMap: 1,
+	eks.AuthenticationModeApi:             2,
+}
+
+func validateAuthenticationModeChange(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("access_config.0.authentication_mode") {
+		return nil
+	}
+
+	o, n := diff.GetChange("access_config.0.authentication_mode")
+	oldMode, newMode := o.(string), n.(string)
+
+	if oldMode == "" || newMode == "" {
+		return nil
+	}
+
+	if authenticationModeOrder[newMode] < authenticationModeOrder[oldMode] {
+		return fmt.Errorf("access_config.0.authentication_mode can't be changed from %s to %s: the authentication mode can only change from %s to %s to %s", oldMode, newMode, eks.AuthenticationModeConfigMap, eks.AuthenticationModeApiAndConfigMap, eks.AuthenticationModeApi)
+	}
+
+	return nil
+}
+
+// forceNewIfBootstrapClusterCreatorAdminPermissionsChange replaces a cluster whose bootstrap_cluster_creator_admin_permissions changes.
+// Clusters created before access entries were available never report the value, so configuring it for such a cluster only records it.
+func forceNewIfBootstrapClusterCreatorAdminPermissionsChange(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	const key = "access_config.0.bootstrap_cluster_creator_admin_permissions"
+
+	if diff.Id() == "" || !diff.HasChange(key) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	cluster, err := FindClusterByName(ctx, conn, diff.Id())
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Cluster (%s): %w", diff.Id(), err)
+	}
+
+	if cluster.AccessConfig == nil || cluster.AccessConfig.BootstrapClusterCreatorAdminPermissions == nil {
+		return nil
+	}
+
+	return diff.ForceNew(key)
+}
+
+func expandCreateAccessConfigRequest(tfList []interface{}) *eks.CreateAccessConfigRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &eks.CreateAccessConfigRequest{}
+
+	if tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["authentication_mode"].(string); ok && v != "" {
+		apiObject.AuthenticationMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 	if len(tfList) == 0 {
 		return nil
@@ -872,6 +1009,23 @@ func expandLogging(vEnabledLogTypes *schema.Set) *eks.Logging {
 	}
 }
 
+func flattenAccessConfigResponse(apiObject *eks.AccessConfigResponse, bootstrapClusterCreatorAdminPermissions bool) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"authentication_mode":                         aws.StringValue(apiObject.AuthenticationMode),
+		"bootstrap_cluster_creator_admin_permissions": bootstrapClusterCreatorAdminPermissions,
+	}
+
+	if v := apiObject.BootstrapClusterCreatorAdminPermissions; v != nil {
+		tfMap["bootstrap_cluster_creator_admin_permissions"] = aws.BoolValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenCertificate(certificate *eks.Certificate) []map[string]interface{} {
 	if certificate == nil {
 		return []map[string]interface{}{}
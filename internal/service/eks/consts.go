@@ -24,3 +24,19 @@ func Resources_Values() []string {
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	AccessEntryTypeEC2Linux     = "EC2_LINUX"
+	AccessEntryTypeEC2Windows   = "EC2_WINDOWS"
+	AccessEntryTypeFargateLinux = "FARGATE_LINUX"
+	AccessEntryTypeStandard     = "STANDARD"
+)
+
+func AccessEntryType_Values() []string {
+	return []string{
+		AccessEntryTypeEC2Linux,
+		AccessEntryTypeEC2Windows,
+		AccessEntryTypeFargateLinux,
+		AccessEntryTypeStandard,
+	}
+}
@@ -83,3 +83,61 @@ func NodeGroupParseResourceID(id string) (string, string, error) {
 
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]snode-group-name", id, nodeGroupResourceIDSeparator)
 }
+
+const accessEntryResourceIDSeparator = ":"
+
+func AccessEntryCreateResourceID(clusterName, principalARN string) string {
+	parts := []string{clusterName, principalARN}
+	id := strings.Join(parts, accessEntryResourceIDSeparator)
+
+	return id
+}
+
+func AccessEntryParseResourceID(id string) (string, string, error) {
+	// Cluster names can't contain the separator but principal ARNs do.
+	parts := strings.SplitN(id, accessEntryResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]sprincipal-arn", id, accessEntryResourceIDSeparator)
+}
+
+const accessPolicyAssociationResourceIDSeparator = "#"
+
+func AccessPolicyAssociationCreateResourceID(clusterName, principalARN, policyARN string) string {
+	parts := []string{clusterName, principalARN, policyARN}
+	id := strings.Join(parts, accessPolicyAssociationResourceIDSeparator)
+
+	return id
+}
+
+func AccessPolicyAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, accessPolicyAssociationResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]sprincipal-arn%[2]spolicy-arn", id, accessPolicyAssociationResourceIDSeparator)
+}
+
+const podIdentityAssociationResourceIDSeparator = ","
+
+func PodIdentityAssociationCreateResourceID(clusterName, associationID string) string {
+	parts := []string{clusterName, associationID}
+	id := strings.Join(parts, podIdentityAssociationResourceIDSeparator)
+
+	return id
+}
+
+func PodIdentityAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, podIdentityAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]sassociation-id", id, podIdentityAssociationResourceIDSeparator)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_eks_pod_identity_association", name="Pod Identity Association")
+// @Tags(identifierAttribute="association_arn")
+func ResourcePodIdentityAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePodIdentityAssociationCreate,
+		ReadWithoutTimeout:   resourcePodIdentityAssociationRead,
+		UpdateWithoutTimeout: resourcePodIdentityAssociationUpdate,
+		DeleteWithoutTimeout: resourcePodIdentityAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"association_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"service_account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourcePodIdentityAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	input := &eks.CreatePodIdentityAssociationInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+		Namespace:          aws.String(d.Get("namespace").(string)),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+		ServiceAccount:     aws.String(d.Get("service_account").(string)),
+		Tags:               getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePodIdentityAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EKS Pod Identity Association (%s): %s", clusterName, err)
+	}
+
+	d.SetId(PodIdentityAssociationCreateResourceID(clusterName, aws.StringValue(output.Association.AssociationId)))
+
+	return resourcePodIdentityAssociationRead(ctx, d, meta)
+}
+
+func resourcePodIdentityAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindPodIdentityAssociationByTwoPartKey(ctx, conn, clusterName, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Pod Identity Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EKS Pod Identity Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("association_arn", output.AssociationArn)
+	d.Set("association_id", output.AssociationId)
+	d.Set("cluster_name", output.ClusterName)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("modified_at", aws.TimeValue(output.ModifiedAt).Format(time.RFC3339))
+	d.Set("namespace", output.Namespace)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("service_account", output.ServiceAccount)
+
+	setTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourcePodIdentityAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	if d.HasChange("role_arn") {
+		clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &eks.UpdatePodIdentityAssociationInput{
+			AssociationId:      aws.String(associationID),
+			ClientRequestToken: aws.String(id.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			RoleArn:            aws.String(d.Get("role_arn").(string)),
+		}
+
+		_, err = conn.UpdatePodIdentityAssociationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Pod Identity Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePodIdentityAssociationRead(ctx, d, meta)
+}
+
+func resourcePodIdentityAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Pod Identity Association: %s", d.Id())
+	_, err = conn.DeletePodIdentityAssociationWithContext(ctx, &eks.DeletePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EKS Pod Identity Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindPodIdentityAssociationByTwoPartKey(ctx context.Context, conn *eks.EKS, clusterName, associationID string) (*eks.PodIdentityAssociation, error) {
+	input := &eks.DescribePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	}
+
+	output, err := conn.DescribePodIdentityAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Association == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Association, nil
+}
+
+func findPodIdentityAssociationSummaries(ctx context.Context, conn *eks.EKS, input *eks.ListPodIdentityAssociationsInput) ([]*eks.PodIdentityAssociationSummary, error) {
+	var output []*eks.PodIdentityAssociationSummary
+
+	err := conn.ListPodIdentityAssociationsPagesWithContext(ctx, input, func(page *eks.ListPodIdentityAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Associations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_eks_pod_identity_association")
+func DataSourcePodIdentityAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePodIdentityAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"association_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"association_id", "service_account"},
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"service_account"},
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"namespace"},
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourcePodIdentityAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	clusterName := d.Get("cluster_name").(string)
+	associationID := d.Get("association_id").(string)
+
+	if associationID == "" {
+		input := &eks.ListPodIdentityAssociationsInput{
+			ClusterName:    aws.String(clusterName),
+			Namespace:      aws.String(d.Get("namespace").(string)),
+			ServiceAccount: aws.String(d.Get("service_account").(string)),
+		}
+
+		summaries, err := findPodIdentityAssociationSummaries(ctx, conn, input)
+
+		if err != nil {
+			return diag.Errorf("reading EKS Pod Identity Associations (%s): %s", clusterName, err)
+		}
+
+		summary, err := tfresource.AssertSinglePtrResult(summaries)
+
+		if err != nil {
+			return diag.FromErr(tfresource.SingularDataSourceFindError("EKS Pod Identity Association", err))
+		}
+
+		associationID = aws.StringValue(summary.AssociationId)
+	}
+
+	id := PodIdentityAssociationCreateResourceID(clusterName, associationID)
+
+	association, err := FindPodIdentityAssociationByTwoPartKey(ctx, conn, clusterName, associationID)
+
+	if err != nil {
+		return diag.Errorf("reading EKS Pod Identity Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("association_arn", association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set("cluster_name", association.ClusterName)
+	d.Set("created_at", aws.TimeValue(association.CreatedAt).Format(time.RFC3339))
+	d.Set("modified_at", aws.TimeValue(association.ModifiedAt).Format(time.RFC3339))
+	d.Set("namespace", association.Namespace)
+	d.Set("role_arn", association.RoleArn)
+	d.Set("service_account", association.ServiceAccount)
+
+	if err := d.Set("tags", KeyValueTags(ctx, association.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	return nil
+}
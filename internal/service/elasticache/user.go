@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,6 +40,10 @@ func ResourceUser() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("passwords"), cty.GetAttrPath("passwords_wo")),
+		},
+
 		CustomizeDiff: verify.SetTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -99,15 +104,39 @@ func ResourceUser() *schema.Resource {
 				Default:  false,
 			},
 			"passwords": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 2,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      2,
+				ConflictsWith: []string{"passwords_wo"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(16, 128),
 				},
 				Sensitive: true,
 			},
+			// Write-only attributes can't be sets, so each password is a block.
+			"passwords_wo": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      2,
+				ConflictsWith: []string{"passwords"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password": {
+							Type:         schema.TypeString,
+							Required:     true,
+							WriteOnly:    true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(16, 128),
+						},
+					},
+				},
+			},
+			"passwords_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"passwords_wo"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"user_id": {
@@ -146,6 +175,16 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Passwords = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	passwordsWO, ds := expandPasswordsWriteOnly(d)
+	diags = append(diags, ds...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(passwordsWO) > 0 {
+		input.Passwords = passwordsWO
+	}
+
 	output, err := conn.CreateUserWithContext(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -247,6 +286,18 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.Passwords = flex.ExpandStringSet(d.Get("passwords").(*schema.Set))
 		}
 
+		if d.HasChanges("passwords_wo", "passwords_wo_version") {
+			passwordsWO, ds := expandPasswordsWriteOnly(d)
+			diags = append(diags, ds...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if len(passwordsWO) > 0 {
+				input.Passwords = passwordsWO
+			}
+		}
+
 		_, err := conn.ModifyUserWithContext(ctx, input)
 
 		if err != nil {
@@ -388,6 +439,28 @@ func waitUserDeleted(ctx context.Context, conn *elasticache.ElastiCache, id stri
 	return nil, err
 }
 
+// expandPasswordsWriteOnly returns the configured passwords_wo passwords.
+func expandPasswordsWriteOnly(d *schema.ResourceData) ([]*string, diag.Diagnostics) {
+	v, diags := flex.GetWriteOnlyValue(d, cty.GetAttrPath("passwords_wo"), cty.List(cty.Object(map[string]cty.Type{
+		"password": cty.String,
+	})))
+	if diags.HasError() || v.IsNull() || !v.IsKnown() {
+		return nil, diags
+	}
+
+	var passwords []*string
+
+	for it := v.ElementIterator(); it.Next(); {
+		_, tfObject := it.Element()
+
+		if v := tfObject.GetAttr("password"); v.IsKnown() && !v.IsNull() {
+			passwords = append(passwords, aws.String(v.AsString()))
+		}
+	}
+
+	return passwords, diags
+}
+
 func expandAuthenticationMode(tfMap map[string]interface{}) *elasticache.AuthenticationMode {
 	if tfMap == nil {
 		return nil
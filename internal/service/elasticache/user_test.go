@@ -6,12 +6,14 @@ package elasticache_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfelasticache "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
@@ -53,6 +55,57 @@ func TestAccElastiCacheUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheUser_passwordsWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user elasticache.User
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_elasticache_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_passwordsWriteOnly(rName, []string{"password123456789"}, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "passwords.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "passwords_wo.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "passwords_wo.0.password"),
+					resource.TestCheckResourceAttr(resourceName, "passwords_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "1"),
+				),
+			},
+			{
+				// Rotate by adding a second password, then dropping the first.
+				Config: testAccUserConfig_passwordsWriteOnly(rName, []string{"password123456789", "password987654321"}, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "passwords_wo.#", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "passwords_wo.0.password"),
+					resource.TestCheckNoResourceAttr(resourceName, "passwords_wo.1.password"),
+					resource.TestCheckResourceAttr(resourceName, "passwords_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "2"),
+				),
+			},
+			{
+				Config: testAccUserConfig_passwordsWriteOnly(rName, []string{"password987654321"}, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "passwords_wo.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "passwords_wo_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheUser_password_auth_mode(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user elasticache.User
@@ -366,6 +419,27 @@ resource "aws_elasticache_user" "test" {
 `, rName)
 }
 
+func testAccUserConfig_passwordsWriteOnly(rName string, passwords []string, passwordsVersion int) string {
+	var blocks strings.Builder
+	for _, password := range passwords {
+		fmt.Fprintf(&blocks, `
+  passwords_wo {
+    password = %q
+  }
+`, password)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id              = %[1]q
+  user_name            = "username1"
+  access_string        = "on ~app::* -@all +@read +@hash +@bitmap +@geo -setbit -bitfield -hset -hsetnx -hmset -hincrby -hincrbyfloat -hdel -bitop -geoadd -georadius -georadiusbymember"
+  engine               = "REDIS"
+  passwords_wo_version = %[3]d
+%[2]s}
+`, rName, blocks.String(), passwordsVersion)
+}
+
 func testAccUserConfigWithPasswordAuthMode_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_user" "test" {
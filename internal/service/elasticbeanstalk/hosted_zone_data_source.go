@@ -44,6 +44,7 @@ var HostedZoneIDs = map[string]string{
 }
 
 // @SDKDataSource("aws_elastic_beanstalk_hosted_zone")
+// @Region(overrideEnabled=false)
 func DataSourceHostedZone() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceHostedZoneRead,
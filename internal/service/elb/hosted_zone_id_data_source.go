@@ -50,6 +50,7 @@ var HostedZoneIdPerRegionMap = map[string]string{
 }
 
 // @SDKDataSource("aws_elb_hosted_zone_id")
+// @Region(overrideEnabled=false)
 func DataSourceHostedZoneID() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceHostedZoneIDRead,
@@ -8,3 +8,17 @@ const (
 
 	TagsOnCreationErrMessage = "cannot specify tags on creation"
 )
+
+const (
+	mutualAuthenticationOff         = "off"
+	mutualAuthenticationVerify      = "verify"
+	mutualAuthenticationPassthrough = "passthrough"
+)
+
+func mutualAuthenticationModeEnum_Values() []string {
+	return []string{
+		mutualAuthenticationOff,
+		mutualAuthenticationVerify,
+		mutualAuthenticationPassthrough,
+	}
+}
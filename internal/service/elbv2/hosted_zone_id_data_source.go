@@ -89,6 +89,7 @@ var HostedZoneIdPerRegionNLBMap = map[string]string{
 }
 
 // @SDKDataSource("aws_lb_hosted_zone_id")
+// @Region(overrideEnabled=false)
 func DataSourceHostedZoneID() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceHostedZoneIDRead,
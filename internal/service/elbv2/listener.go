@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -52,6 +53,7 @@ func ResourceListener() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			customizeDiffMutualAuthentication,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -355,6 +357,31 @@ func ResourceListener() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"mutual_authentication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ignore_client_certificate_expiry": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mutualAuthenticationModeEnum_Values(), false),
+						},
+						"trust_store_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -395,6 +422,42 @@ func suppressIfDefaultActionTypeNot(t string) schema.SchemaDiffSuppressFunc {
 	}
 }
 
+// customizeDiffMutualAuthentication requires a trust store when verifying client certificates and turns mutual authentication off
+// when the `mutual_authentication` block is removed, which would otherwise keep its Computed value.
+func customizeDiffMutualAuthentication(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	config := diff.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	if v := config.GetAttr("mutual_authentication"); !v.IsKnown() {
+		return nil
+	} else if !v.IsNull() && v.LengthInt() > 0 {
+		tfMap := v.Index(cty.NumberIntVal(0))
+		if mode := tfMap.GetAttr("mode"); mode.IsKnown() && !mode.IsNull() && mode.AsString() == mutualAuthenticationVerify && tfMap.GetAttr("trust_store_arn").IsNull() {
+			return fmt.Errorf("mutual_authentication.0.trust_store_arn must be set when mode is %q", mutualAuthenticationVerify)
+		}
+
+		return nil
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if o, _ := diff.GetChange("mutual_authentication"); len(o.([]interface{})) > 0 && o.([]interface{})[0] != nil {
+		if mode := o.([]interface{})[0].(map[string]interface{})["mode"].(string); mode != "" && mode != mutualAuthenticationOff {
+			return diff.SetNew("mutual_authentication", []interface{}{map[string]interface{}{
+				"ignore_client_certificate_expiry": false,
+				"mode":                             mutualAuthenticationOff,
+				"trust_store_arn":                  "",
+			}})
+		}
+	}
+
+	return nil
+}
+
 func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
@@ -425,6 +488,10 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("mutual_authentication"); ok && len(v.([]interface{})) > 0 {
+		input.MutualAuthentication = expandMutualAuthenticationAttributes(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("port"); ok {
 		input.Port = aws.Int64(int64(v.(int)))
 	}
@@ -549,6 +616,10 @@ func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting default_action for ELBv2 listener (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("mutual_authentication", flattenMutualAuthenticationAttributes(listener.MutualAuthentication)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mutual_authentication for ELBv2 listener (%s): %s", d.Id(), err)
+	}
+
 	return diags
 }
 
@@ -595,6 +666,10 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
+		if d.HasChange("mutual_authentication") {
+			input.MutualAuthentication = expandMutualAuthenticationAttributes(d.Get("mutual_authentication").([]interface{}))
+		}
+
 		err := retry.RetryContext(ctx, loadBalancerListenerUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.ModifyListenerWithContext(ctx, input)
 
@@ -1086,3 +1161,47 @@ func flattenLbListenerActionRedirectConfig(config *elbv2.RedirectActionConfig) [
 
 	return []interface{}{m}
 }
+
+func expandMutualAuthenticationAttributes(l []interface{}) *elbv2.MutualAuthenticationAttributes {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	switch mode := tfMap["mode"].(string); mode {
+	case mutualAuthenticationOff, mutualAuthenticationPassthrough:
+		// A trust store and certificate expiry handling only apply when verifying client certificates.
+		return &elbv2.MutualAuthenticationAttributes{
+			Mode: aws.String(mode),
+		}
+	default:
+		apiObject := &elbv2.MutualAuthenticationAttributes{
+			IgnoreClientCertificateExpiry: aws.Bool(tfMap["ignore_client_certificate_expiry"].(bool)),
+			Mode:                          aws.String(mode),
+		}
+
+		if v, ok := tfMap["trust_store_arn"].(string); ok && v != "" {
+			apiObject.TrustStoreArn = aws.String(v)
+		}
+
+		return apiObject
+	}
+}
+
+func flattenMutualAuthenticationAttributes(config *elbv2.MutualAuthenticationAttributes) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"ignore_client_certificate_expiry": aws.BoolValue(config.IgnoreClientCertificateExpiry),
+		"mode":                             aws.StringValue(config.Mode),
+		"trust_store_arn":                  aws.StringValue(config.TrustStoreArn),
+	}
+
+	return []interface{}{m}
+}
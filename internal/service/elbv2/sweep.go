@@ -40,6 +40,14 @@ func init() {
 		Name: "aws_lb_listener",
 		F:    sweepListeners,
 	})
+
+	resource.AddTestSweepers("aws_lb_trust_store", &resource.Sweeper{
+		Name: "aws_lb_trust_store",
+		F:    sweepTrustStores,
+		Dependencies: []string{
+			"aws_lb_listener",
+		},
+	})
 }
 
 func sweepLoadBalancers(region string) error {
@@ -182,3 +190,47 @@ func sweepListeners(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepTrustStores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.ELBV2Conn(ctx)
+	input := &elbv2.DescribeTrustStoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeTrustStoresPagesWithContext(ctx, input, func(page *elbv2.DescribeTrustStoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TrustStores {
+			r := ResourceTrustStore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.TrustStoreArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ELBv2 Trust Store sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing ELBv2 Trust Stores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping ELBv2 Trust Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccELBV2TrustStoreRevocation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elbv2.DescribeTrustStoreRevocation
+	resourceName := "aws_lb_trust_store_revocation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreRevocationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreRevocationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreRevocationExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, "revocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "revocations_s3_bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "revocations_s3_key", "aws_s3_object.crl", "key"),
+					resource.TestCheckResourceAttrPair(resourceName, "trust_store_arn", "aws_lb_trust_store.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"revocations_s3_bucket",
+					"revocations_s3_key",
+				},
+			},
+		},
+	})
+}
+
+func TestAccELBV2TrustStoreRevocation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elbv2.DescribeTrustStoreRevocation
+	resourceName := "aws_lb_trust_store_revocation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreRevocationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreRevocationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreRevocationExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfelbv2.ResourceTrustStoreRevocation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrustStoreRevocationExists(ctx context.Context, n string, v *elbv2.DescribeTrustStoreRevocation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ELBv2 Trust Store Revocation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn(ctx)
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		revocationID, err := strconv.ParseInt(parts[1], 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfelbv2.FindTrustStoreRevocationByTwoPartKey(ctx, conn, parts[0], revocationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTrustStoreRevocationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lb_trust_store_revocation" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+			if err != nil {
+				return err
+			}
+
+			revocationID, err := strconv.ParseInt(parts[1], 10, 64)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfelbv2.FindTrustStoreRevocationByTwoPartKey(ctx, conn, parts[0], revocationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ELBv2 Trust Store Revocation %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTrustStoreRevocationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "ca" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "%[1]s-ca.pem"
+  source = "test-fixtures/ca.pem"
+}
+
+resource "aws_s3_object" "crl" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "%[1]s-crl.pem"
+  source = "test-fixtures/crl.pem"
+}
+
+resource "aws_lb_trust_store" "test" {
+  name                             = %[1]q
+  ca_certificates_bundle_s3_bucket = aws_s3_bucket.test.bucket
+  ca_certificates_bundle_s3_key    = aws_s3_object.ca.key
+}
+
+resource "aws_lb_trust_store_revocation" "test" {
+  trust_store_arn       = aws_lb_trust_store.test.arn
+  revocations_s3_bucket = aws_s3_bucket.test.bucket
+  revocations_s3_key    = aws_s3_object.crl.key
+}
+`, rName)
+}
@@ -30,6 +30,8 @@ import (
 
 // @SDKResource("aws_finspace_kx_cluster", name="Kx Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("environment_id")
+// @IdentityAttribute("name")
 func ResourceKxCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKxClusterCreate,
@@ -28,6 +28,8 @@ import (
 
 // @SDKResource("aws_finspace_kx_database", name="Kx Database")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("environment_id")
+// @IdentityAttribute("name")
 func ResourceKxDatabase() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKxDatabaseCreate,
@@ -27,6 +27,8 @@ import (
 
 // @SDKResource("aws_finspace_kx_user", name="Kx User")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("environment_id")
+// @IdentityAttribute("name")
 func ResourceKxUser() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKxUserCreate,
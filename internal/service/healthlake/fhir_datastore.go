@@ -0,0 +1,601 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+func newResourceFHIRDatastore(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceFHIRDatastore{}
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type resourceFHIRDatastore struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceFHIRDatastore) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_healthlake_fhir_datastore"
+}
+
+func (r *resourceFHIRDatastore) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_type_version": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.FHIRVersion](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"identity_provider_configuration": schema.ListNestedBlock{
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authorization_strategy": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.AuthorizationStrategy](),
+							},
+						},
+						"fine_grained_authorization_enabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+						"idp_lambda_arn": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"metadata": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"preload_data_config": schema.ListNestedBlock{
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"preload_data_type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.PreloadDataType](),
+							},
+						},
+					},
+				},
+			},
+			"sse_configuration": schema.ListNestedBlock{
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kms_encryption_config": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"cmk_type": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.String{
+											enum.FrameworkValidate[awstypes.CmkType](),
+										},
+									},
+									"kms_key_id": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceFHIRDatastore) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	input := &healthlake.CreateFHIRDatastoreInput{
+		ClientToken:                   aws.String(id.UniqueId()),
+		DatastoreTypeVersion:          awstypes.FHIRVersion(data.DatastoreTypeVersion.ValueString()),
+		IdentityProviderConfiguration: expandIdentityProviderConfiguration(ctx, data.IdentityProviderConfiguration),
+		PreloadDataConfig:             expandPreloadDataConfig(ctx, data.PreloadDataConfig),
+		SseConfiguration:              expandSSEConfiguration(ctx, data.SSEConfiguration),
+		Tags:                          getTagsIn(ctx),
+	}
+
+	if !data.Name.IsUnknown() {
+		input.DatastoreName = flex.StringFromFramework(ctx, data.Name)
+	}
+
+	output, err := conn.CreateFHIRDatastore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating HealthLake FHIR Datastore (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.DatastoreId)
+
+	// Set the ID so that a datastore that fails to become active is tracked in state.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	datastore, err := waitFHIRDatastoreCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Datastore (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.refreshFromOutput(ctx, datastore)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFHIRDatastore) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	output, err := findFHIRDatastoreByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Datastore (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFHIRDatastore) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+	var data resourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFHIRDatastore) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	tflog.Debug(ctx, "deleting HealthLake FHIR Datastore", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteFHIRDatastore(ctx, &healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting HealthLake FHIR Datastore (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Datastore (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceFHIRDatastore) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceFHIRDatastoreData struct {
+	ARN                           types.String   `tfsdk:"arn"`
+	CreatedAt                     types.String   `tfsdk:"created_at"`
+	DatastoreEndpoint             types.String   `tfsdk:"datastore_endpoint"`
+	DatastoreTypeVersion          types.String   `tfsdk:"datastore_type_version"`
+	ID                            types.String   `tfsdk:"id"`
+	IdentityProviderConfiguration types.List     `tfsdk:"identity_provider_configuration"`
+	Name                          types.String   `tfsdk:"name"`
+	PreloadDataConfig             types.List     `tfsdk:"preload_data_config"`
+	SSEConfiguration              types.List     `tfsdk:"sse_configuration"`
+	Tags                          types.Map      `tfsdk:"tags"`
+	TagsAll                       types.Map      `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *resourceFHIRDatastoreData) refreshFromOutput(ctx context.Context, apiObject *awstypes.DatastoreProperties) {
+	data.ARN = flex.StringToFramework(ctx, apiObject.DatastoreArn)
+	data.CreatedAt = flex.StringValueToFramework(ctx, aws.ToTime(apiObject.CreatedAt).Format(time.RFC3339))
+	data.DatastoreEndpoint = flex.StringToFramework(ctx, apiObject.DatastoreEndpoint)
+	data.DatastoreTypeVersion = flex.StringValueToFramework(ctx, apiObject.DatastoreTypeVersion)
+	data.Name = flex.StringToFramework(ctx, apiObject.DatastoreName)
+	data.PreloadDataConfig = flattenPreloadDataConfig(ctx, apiObject.PreloadDataConfig)
+
+	// The service returns its defaults when no identity provider or SSE configuration is specified.
+	if v := apiObject.IdentityProviderConfiguration; len(data.IdentityProviderConfiguration.Elements()) > 0 || (v != nil && v.AuthorizationStrategy != awstypes.AuthorizationStrategyAwsAuth) {
+		data.IdentityProviderConfiguration = flattenIdentityProviderConfiguration(ctx, v)
+	} else {
+		data.IdentityProviderConfiguration = types.ListValueMust(types.ObjectType{AttrTypes: identityProviderConfigurationAttrTypes}, []attr.Value{})
+	}
+
+	if v := apiObject.SseConfiguration; len(data.SSEConfiguration.Elements()) > 0 || (v != nil && v.KmsEncryptionConfig != nil && v.KmsEncryptionConfig.CmkType != awstypes.CmkTypeAoCmk) {
+		data.SSEConfiguration = flattenSSEConfiguration(ctx, v)
+	} else {
+		data.SSEConfiguration = types.ListValueMust(types.ObjectType{AttrTypes: sseConfigurationAttrTypes}, []attr.Value{})
+	}
+}
+
+type identityProviderConfigurationData struct {
+	AuthorizationStrategy           types.String `tfsdk:"authorization_strategy"`
+	FineGrainedAuthorizationEnabled types.Bool   `tfsdk:"fine_grained_authorization_enabled"`
+	IdPLambdaARN                    types.String `tfsdk:"idp_lambda_arn"`
+	Metadata                        types.String `tfsdk:"metadata"`
+}
+
+type preloadDataConfigData struct {
+	PreloadDataType types.String `tfsdk:"preload_data_type"`
+}
+
+type sseConfigurationData struct {
+	KMSEncryptionConfig types.List `tfsdk:"kms_encryption_config"`
+}
+
+type kmsEncryptionConfigData struct {
+	CMKType  types.String `tfsdk:"cmk_type"`
+	KMSKeyID types.String `tfsdk:"kms_key_id"`
+}
+
+var (
+	identityProviderConfigurationAttrTypes = map[string]attr.Type{
+		"authorization_strategy":             types.StringType,
+		"fine_grained_authorization_enabled": types.BoolType,
+		"idp_lambda_arn":                     types.StringType,
+		"metadata":                           types.StringType,
+	}
+
+	preloadDataConfigAttrTypes = map[string]attr.Type{
+		"preload_data_type": types.StringType,
+	}
+
+	kmsEncryptionConfigAttrTypes = map[string]attr.Type{
+		"cmk_type":   types.StringType,
+		"kms_key_id": types.StringType,
+	}
+
+	sseConfigurationAttrTypes = map[string]attr.Type{
+		"kms_encryption_config": types.ListType{ElemType: types.ObjectType{AttrTypes: kmsEncryptionConfigAttrTypes}},
+	}
+)
+
+func expandIdentityProviderConfiguration(ctx context.Context, tfList types.List) *awstypes.IdentityProviderConfiguration {
+	return flex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data identityProviderConfigurationData) *awstypes.IdentityProviderConfiguration {
+		return &awstypes.IdentityProviderConfiguration{
+			AuthorizationStrategy:           awstypes.AuthorizationStrategy(data.AuthorizationStrategy.ValueString()),
+			FineGrainedAuthorizationEnabled: data.FineGrainedAuthorizationEnabled.ValueBool(),
+			IdpLambdaArn:                    flex.StringFromFramework(ctx, data.IdPLambdaARN),
+			Metadata:                        flex.StringFromFramework(ctx, data.Metadata),
+		}
+	})
+}
+
+func expandPreloadDataConfig(ctx context.Context, tfList types.List) *awstypes.PreloadDataConfig {
+	return flex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data preloadDataConfigData) *awstypes.PreloadDataConfig {
+		return &awstypes.PreloadDataConfig{
+			PreloadDataType: awstypes.PreloadDataType(data.PreloadDataType.ValueString()),
+		}
+	})
+}
+
+func expandSSEConfiguration(ctx context.Context, tfList types.List) *awstypes.SseConfiguration {
+	return flex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data sseConfigurationData) *awstypes.SseConfiguration {
+		return &awstypes.SseConfiguration{
+			KmsEncryptionConfig: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.KMSEncryptionConfig, func(ctx context.Context, data kmsEncryptionConfigData) *awstypes.KmsEncryptionConfig {
+				apiObject := &awstypes.KmsEncryptionConfig{
+					CmkType: awstypes.CmkType(data.CMKType.ValueString()),
+				}
+
+				if !data.KMSKeyID.IsUnknown() {
+					apiObject.KmsKeyId = flex.StringFromFramework(ctx, data.KMSKeyID)
+				}
+
+				return apiObject
+			}),
+		}
+	})
+}
+
+func flattenIdentityProviderConfiguration(ctx context.Context, apiObject *awstypes.IdentityProviderConfiguration) types.List {
+	elemType := types.ObjectType{AttrTypes: identityProviderConfigurationAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{})
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(identityProviderConfigurationAttrTypes, map[string]attr.Value{
+			"authorization_strategy":             flex.StringValueToFramework(ctx, apiObject.AuthorizationStrategy),
+			"fine_grained_authorization_enabled": types.BoolValue(apiObject.FineGrainedAuthorizationEnabled),
+			"idp_lambda_arn":                     flex.StringToFramework(ctx, apiObject.IdpLambdaArn),
+			"metadata":                           flex.StringToFramework(ctx, apiObject.Metadata),
+		}),
+	})
+}
+
+func flattenPreloadDataConfig(ctx context.Context, apiObject *awstypes.PreloadDataConfig) types.List {
+	elemType := types.ObjectType{AttrTypes: preloadDataConfigAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{})
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(preloadDataConfigAttrTypes, map[string]attr.Value{
+			"preload_data_type": flex.StringValueToFramework(ctx, apiObject.PreloadDataType),
+		}),
+	})
+}
+
+func flattenSSEConfiguration(ctx context.Context, apiObject *awstypes.SseConfiguration) types.List {
+	elemType := types.ObjectType{AttrTypes: sseConfigurationAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{})
+	}
+
+	kmsEncryptionConfigElemType := types.ObjectType{AttrTypes: kmsEncryptionConfigAttrTypes}
+	kmsEncryptionConfig := types.ListValueMust(kmsEncryptionConfigElemType, []attr.Value{})
+
+	if v := apiObject.KmsEncryptionConfig; v != nil {
+		kmsEncryptionConfig = types.ListValueMust(kmsEncryptionConfigElemType, []attr.Value{
+			types.ObjectValueMust(kmsEncryptionConfigAttrTypes, map[string]attr.Value{
+				"cmk_type":   flex.StringValueToFramework(ctx, v.CmkType),
+				"kms_key_id": flex.StringToFramework(ctx, v.KmsKeyId),
+			}),
+		})
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(sseConfigurationAttrTypes, map[string]attr.Value{
+			"kms_encryption_config": kmsEncryptionConfig,
+		}),
+	})
+}
+
+func findFHIRDatastoreByID(ctx context.Context, conn *healthlake.Client, id string) (*awstypes.DatastoreProperties, error) {
+	input := &healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+
+	output, err := conn.DescribeFHIRDatastore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.DatastoreProperties.DatastoreStatus; status == awstypes.DatastoreStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.DatastoreProperties, nil
+}
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFHIRDatastoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DatastoreStatus), nil
+	}
+}
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DatastoreStatusCreating),
+		Target:     enum.Slice(awstypes.DatastoreStatusActive),
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Minute,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DatastoreStatusActive, awstypes.DatastoreStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
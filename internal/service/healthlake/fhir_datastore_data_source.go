@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource
+func newDataSourceFHIRDatastore(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceFHIRDatastore{}, nil
+}
+
+type dataSourceFHIRDatastore struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceFHIRDatastore) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_healthlake_fhir_datastore"
+}
+
+func (d *dataSourceFHIRDatastore) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_type_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Required: true,
+			},
+			"identity_provider_configuration": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: identityProviderConfigurationAttrTypes},
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"preload_data_config": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: preloadDataConfigAttrTypes},
+				Computed:    true,
+			},
+			"sse_configuration": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: sseConfigurationAttrTypes},
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *dataSourceFHIRDatastore) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().HealthLakeClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	output, err := findFHIRDatastoreByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Datastore (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.DatastoreArn)
+	data.CreatedAt = flex.StringValueToFramework(ctx, aws.ToTime(output.CreatedAt).Format(time.RFC3339))
+	data.DatastoreEndpoint = flex.StringToFramework(ctx, output.DatastoreEndpoint)
+	data.DatastoreStatus = flex.StringValueToFramework(ctx, output.DatastoreStatus)
+	data.DatastoreTypeVersion = flex.StringValueToFramework(ctx, output.DatastoreTypeVersion)
+	data.IdentityProviderConfiguration = flattenIdentityProviderConfiguration(ctx, output.IdentityProviderConfiguration)
+	data.Name = flex.StringToFramework(ctx, output.DatastoreName)
+	data.PreloadDataConfig = flattenPreloadDataConfig(ctx, output.PreloadDataConfig)
+	data.SSEConfiguration = flattenSSEConfiguration(ctx, output.SseConfiguration)
+
+	tags, err := listTags(ctx, conn, aws.ToString(output.DatastoreArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for HealthLake FHIR Datastore (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceFHIRDatastoreData struct {
+	ARN                           types.String `tfsdk:"arn"`
+	CreatedAt                     types.String `tfsdk:"created_at"`
+	DatastoreEndpoint             types.String `tfsdk:"datastore_endpoint"`
+	DatastoreStatus               types.String `tfsdk:"datastore_status"`
+	DatastoreTypeVersion          types.String `tfsdk:"datastore_type_version"`
+	ID                            types.String `tfsdk:"id"`
+	IdentityProviderConfiguration types.List   `tfsdk:"identity_provider_configuration"`
+	Name                          types.String `tfsdk:"name"`
+	PreloadDataConfig             types.List   `tfsdk:"preload_data_config"`
+	SSEConfiguration              types.List   `tfsdk:"sse_configuration"`
+	Tags                          types.Map    `tfsdk:"tags"`
+}
@@ -5,3 +5,66 @@
 // +build sweep
 
 package healthlake
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_healthlake_fhir_datastore", &resource.Sweeper{
+		Name: "aws_healthlake_fhir_datastore",
+		F:    sweepFHIRDatastores,
+	})
+}
+
+func sweepFHIRDatastores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.HealthLakeClient(ctx)
+	input := &healthlake.ListFHIRDatastoresInput{
+		Filter: &awstypes.DatastoreFilter{
+			DatastoreStatus: awstypes.DatastoreStatusActive,
+		},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := healthlake.NewListFHIRDatastoresPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping HealthLake FHIR Datastore sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing HealthLake FHIR Datastores (%s): %w", region, err)
+		}
+
+		for _, v := range page.DatastorePropertiesList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceFHIRDatastore, client,
+				framework.NewAttribute("id", aws.ToString(v.DatastoreId)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping HealthLake FHIR Datastores (%s): %w", region, err)
+	}
+
+	return nil
+}
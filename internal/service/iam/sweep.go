@@ -27,6 +27,9 @@ import (
 )
 
 func init() {
+	// Instance Profiles swept by the Role sweeper are deleted before their Roles.
+	sweep.AddDependencies("aws_iam_instance_profile", "aws_iam_role")
+
 	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
@@ -251,57 +254,73 @@ func sweepInstanceProfile(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.IAMConn(ctx)
+	input := &iam.ListInstanceProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	var sweeperErrs *multierror.Error
 
-	err = conn.ListInstanceProfilesPagesWithContext(ctx, &iam.ListInstanceProfilesInput{}, func(page *iam.ListInstanceProfilesOutput, lastPage bool) bool {
+	err = conn.ListInstanceProfilesPagesWithContext(ctx, input, func(page *iam.ListInstanceProfilesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
-		for _, instanceProfile := range page.InstanceProfiles {
-			name := aws.StringValue(instanceProfile.InstanceProfileName)
+		for _, v := range page.InstanceProfiles {
+			name := aws.StringValue(v.InstanceProfileName)
 
 			if !roleNameFilter(name) {
 				log.Printf("[INFO] Skipping IAM Instance Profile (%s): no match on allow-list", name)
 				continue
 			}
 
-			r := ResourceInstanceProfile()
-			d := r.Data(nil)
-			d.SetId(name)
-
-			roles := instanceProfile.Roles
-			if r := len(roles); r > 1 {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("unexpected number of roles for IAM Instance Profile (%s): %d", name, r))
-			} else if r == 1 {
-				d.Set("role", roles[0].RoleName)
-			}
-
-			log.Printf("[INFO] Sweeping IAM Instance Profile %q", name)
-			err := sdk.DeleteResource(ctx, r, d, client)
+			sweepable, err := newInstanceProfileSweepResource(client, v)
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting IAM Instance Profile (%s): %w", name, err))
+				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
 			}
+
+			sweepResources = append(sweepResources, sweepable)
 		}
 
 		return !lastPage
 	})
 
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM Instance Profile sweep for %q: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		log.Printf("[WARN] Skipping IAM Instance Profile sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
 	}
 
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IAM Instance Profiles: %w", err))
 	}
 
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping IAM Instance Profiles (%s): %w", region, err))
+	}
+
 	return sweeperErrs.ErrorOrNil()
 }
 
+// newInstanceProfileSweepResource returns a sweepable for the specified IAM Instance Profile, removing its role on deletion.
+func newInstanceProfileSweepResource(client *conns.AWSClient, instanceProfile *iam.InstanceProfile) (sweep.Sweepable, error) {
+	name := aws.StringValue(instanceProfile.InstanceProfileName)
+
+	r := ResourceInstanceProfile()
+	d := r.Data(nil)
+	d.SetId(name)
+
+	roles := instanceProfile.Roles
+	if n := len(roles); n > 1 {
+		return nil, fmt.Errorf("unexpected number of roles for IAM Instance Profile (%s): %d", name, n)
+	} else if n == 1 {
+		d.Set("role", roles[0].RoleName)
+	}
+
+	return sweep.NewTypedSweepResource("aws_iam_instance_profile", r, d, client), nil
+}
+
 func sweepOpenIDConnectProvider(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -518,29 +537,84 @@ func sweepRoles(region string) error {
 	}
 
 	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for _, roleName := range roles {
-		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
+		sweepResources = append(sweepResources, sweep.NewTypedSweepable("aws_iam_role", roleSweeper{conn: conn, roleName: roleName}))
 
-		err := DeleteRole(ctx, conn, roleName, true, true, true)
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
+		// Instance Profiles swept with their Role are deleted before it.
+		input := &iam.ListInstanceProfilesForRoleInput{
+			RoleName: aws.String(roleName),
 		}
-		if tfawserr.ErrCodeContains(err, "AccessDenied") {
-			log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
+
+		err := conn.ListInstanceProfilesForRolePagesWithContext(ctx, input, func(page *iam.ListInstanceProfilesForRoleOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.InstanceProfiles {
+				if !roleNameFilter(aws.StringValue(v.InstanceProfileName)) {
+					continue
+				}
+
+				sweepable, err := newInstanceProfileSweepResource(client, v)
+
+				if err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+					continue
+				}
+
+				sweepResources = append(sweepResources, sweepable)
+			}
+
+			return !lastPage
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 			continue
 		}
+
 		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM Role (%s): %w", roleName, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IAM Instance Profiles for IAM Role (%s): %w", roleName, err))
 		}
 	}
 
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping IAM Roles (%s): %w", region, err))
+	}
+
 	return sweeperErrs.ErrorOrNil()
 }
 
+type roleSweeper struct {
+	conn     *iam.IAM
+	roleName string
+}
+
+func (rs roleSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	log.Printf("[DEBUG] Deleting IAM Role (%s)", rs.roleName)
+
+	err := DeleteRole(ctx, rs.conn, rs.roleName, true, true, true)
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Skipping IAM Role (%s): %s", rs.roleName, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Role (%s): %w", rs.roleName, err)
+	}
+
+	return nil
+}
+
+func (rs roleSweeper) String() string {
+	return rs.roleName
+}
+
 func sweepSAMLProvider(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
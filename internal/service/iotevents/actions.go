@@ -0,0 +1,1343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// Detector models and alarm models share the same set of actions.
+// Detector models additionally support timer and variable actions.
+
+func payloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func timerNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+}
+
+func alarmActionSchemaMap() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dynamodb": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hash_key_field": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"hash_key_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"hash_key_value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"operation": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"payload": payloadSchema(),
+					"payload_field": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"range_key_field": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"range_key_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"range_key_value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"table_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"dynamodbv2": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"table_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"firehose": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"delivery_stream_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"payload": payloadSchema(),
+					"separator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"\n", "\t", "\r\n", ","}, false),
+					},
+				},
+			},
+		},
+		"iot_events": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"iot_site_wise": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"asset_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"entry_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_alias": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_value": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"quality": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"timestamp": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"offset_in_nanos": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"time_in_seconds": {
+												Type:     schema.TypeString,
+												Required: true,
+											},
+										},
+									},
+								},
+								"value": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"boolean_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"double_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"integer_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"string_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"iot_topic_publish": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mqtt_topic": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"lambda": lambdaActionSchema(),
+		"sns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"target_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"sqs": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"queue_url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"use_base64": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func lambdaActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"function_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"payload": payloadSchema(),
+			},
+		},
+	}
+}
+
+func detectorActionSchemaMap() map[string]*schema.Schema {
+	m := alarmActionSchemaMap()
+
+	m["clear_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timer_name": timerNameSchema(),
+			},
+		},
+	}
+	m["reset_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timer_name": timerNameSchema(),
+			},
+		},
+	}
+	m["set_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_expression": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use duration_expression instead",
+					ValidateFunc: validation.IntBetween(1, 31622400),
+				},
+				"timer_name": timerNameSchema(),
+			},
+		},
+	}
+	m["set_variable"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"variable_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+
+	return m
+}
+
+func expandPayload(tfList []interface{}) *iotevents.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.Payload{}
+
+	if v, ok := tfMap["content_expression"].(string); ok && v != "" {
+		apiObject.ContentExpression = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandClearTimerAction(tfList []interface{}) *iotevents.ClearTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.ClearTimerAction{}
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDynamoDBAction(tfList []interface{}) *iotevents.DynamoDBAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.DynamoDBAction{}
+
+	if v, ok := tfMap["hash_key_field"].(string); ok && v != "" {
+		apiObject.HashKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["hash_key_type"].(string); ok && v != "" {
+		apiObject.HashKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["hash_key_value"].(string); ok && v != "" {
+		apiObject.HashKeyValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["operation"].(string); ok && v != "" {
+		apiObject.Operation = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap["payload_field"].(string); ok && v != "" {
+		apiObject.PayloadField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_field"].(string); ok && v != "" {
+		apiObject.RangeKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_type"].(string); ok && v != "" {
+		apiObject.RangeKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_value"].(string); ok && v != "" {
+		apiObject.RangeKeyValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDynamoDBv2Action(tfList []interface{}) *iotevents.DynamoDBv2Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.DynamoDBv2Action{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFirehoseAction(tfList []interface{}) *iotevents.FirehoseAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.FirehoseAction{}
+
+	if v, ok := tfMap["delivery_stream_name"].(string); ok && v != "" {
+		apiObject.DeliveryStreamName = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap["separator"].(string); ok && v != "" {
+		apiObject.Separator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIoTEventsAction(tfList []interface{}) *iotevents.Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.Action{}
+
+	if v, ok := tfMap["input_name"].(string); ok && v != "" {
+		apiObject.InputName = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandIoTSiteWiseAction(tfList []interface{}) *iotevents.IotSiteWiseAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.IotSiteWiseAction{}
+
+	if v, ok := tfMap["asset_id"].(string); ok && v != "" {
+		apiObject.AssetId = aws.String(v)
+	}
+
+	if v, ok := tfMap["entry_id"].(string); ok && v != "" {
+		apiObject.EntryId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_alias"].(string); ok && v != "" {
+		apiObject.PropertyAlias = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_id"].(string); ok && v != "" {
+		apiObject.PropertyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_value"].([]interface{}); ok {
+		apiObject.PropertyValue = expandAssetPropertyValue(v)
+	}
+
+	return apiObject
+}
+
+func expandAssetPropertyValue(tfList []interface{}) *iotevents.AssetPropertyValue {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AssetPropertyValue{}
+
+	if v, ok := tfMap["quality"].(string); ok && v != "" {
+		apiObject.Quality = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Timestamp = &iotevents.AssetPropertyTimestamp{}
+
+		if v, ok := tfMap["offset_in_nanos"].(string); ok && v != "" {
+			apiObject.Timestamp.OffsetInNanos = aws.String(v)
+		}
+
+		if v, ok := tfMap["time_in_seconds"].(string); ok && v != "" {
+			apiObject.Timestamp.TimeInSeconds = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Value = &iotevents.AssetPropertyVariant{}
+
+		if v, ok := tfMap["boolean_value"].(string); ok && v != "" {
+			apiObject.Value.BooleanValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["double_value"].(string); ok && v != "" {
+			apiObject.Value.DoubleValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["integer_value"].(string); ok && v != "" {
+			apiObject.Value.IntegerValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["string_value"].(string); ok && v != "" {
+			apiObject.Value.StringValue = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandIoTTopicPublishAction(tfList []interface{}) *iotevents.IotTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.IotTopicPublishAction{}
+
+	if v, ok := tfMap["mqtt_topic"].(string); ok && v != "" {
+		apiObject.MqttTopic = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandLambdaAction(tfList []interface{}) *iotevents.LambdaAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.LambdaAction{}
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandResetTimerAction(tfList []interface{}) *iotevents.ResetTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.ResetTimerAction{}
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSetTimerAction(tfList []interface{}) *iotevents.SetTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.SetTimerAction{}
+
+	if v, ok := tfMap["duration_expression"].(string); ok && v != "" {
+		apiObject.DurationExpression = aws.String(v)
+	}
+
+	if v, ok := tfMap["seconds"].(int); ok && v != 0 {
+		apiObject.Seconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSetVariableAction(tfList []interface{}) *iotevents.SetVariableAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.SetVariableAction{}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	if v, ok := tfMap["variable_name"].(string); ok && v != "" {
+		apiObject.VariableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSNSTopicPublishAction(tfList []interface{}) *iotevents.SNSTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.SNSTopicPublishAction{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap["target_arn"].(string); ok && v != "" {
+		apiObject.TargetArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSQSAction(tfList []interface{}) *iotevents.SqsAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.SqsAction{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap["queue_url"].(string); ok && v != "" {
+		apiObject.QueueUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["use_base64"].(bool); ok && v {
+		apiObject.UseBase64 = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandActionData(tfMap map[string]interface{}) *iotevents.ActionData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.ActionData{}
+
+	if v, ok := tfMap["clear_timer"].([]interface{}); ok {
+		apiObject.ClearTimer = expandClearTimerAction(v)
+	}
+
+	if v, ok := tfMap["dynamodb"].([]interface{}); ok {
+		apiObject.DynamoDB = expandDynamoDBAction(v)
+	}
+
+	if v, ok := tfMap["dynamodbv2"].([]interface{}); ok {
+		apiObject.DynamoDBv2 = expandDynamoDBv2Action(v)
+	}
+
+	if v, ok := tfMap["firehose"].([]interface{}); ok {
+		apiObject.Firehose = expandFirehoseAction(v)
+	}
+
+	if v, ok := tfMap["iot_events"].([]interface{}); ok {
+		apiObject.IotEvents = expandIoTEventsAction(v)
+	}
+
+	if v, ok := tfMap["iot_site_wise"].([]interface{}); ok {
+		apiObject.IotSiteWise = expandIoTSiteWiseAction(v)
+	}
+
+	if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok {
+		apiObject.IotTopicPublish = expandIoTTopicPublishAction(v)
+	}
+
+	if v, ok := tfMap["lambda"].([]interface{}); ok {
+		apiObject.Lambda = expandLambdaAction(v)
+	}
+
+	if v, ok := tfMap["reset_timer"].([]interface{}); ok {
+		apiObject.ResetTimer = expandResetTimerAction(v)
+	}
+
+	if v, ok := tfMap["set_timer"].([]interface{}); ok {
+		apiObject.SetTimer = expandSetTimerAction(v)
+	}
+
+	if v, ok := tfMap["set_variable"].([]interface{}); ok {
+		apiObject.SetVariable = expandSetVariableAction(v)
+	}
+
+	if v, ok := tfMap["sns"].([]interface{}); ok {
+		apiObject.Sns = expandSNSTopicPublishAction(v)
+	}
+
+	if v, ok := tfMap["sqs"].([]interface{}); ok {
+		apiObject.Sqs = expandSQSAction(v)
+	}
+
+	return apiObject
+}
+
+func expandActionDatas(tfList []interface{}) []*iotevents.ActionData {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandActionData(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandAlarmAction(tfMap map[string]interface{}) *iotevents.AlarmAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AlarmAction{}
+
+	if v, ok := tfMap["dynamodb"].([]interface{}); ok {
+		apiObject.DynamoDB = expandDynamoDBAction(v)
+	}
+
+	if v, ok := tfMap["dynamodbv2"].([]interface{}); ok {
+		apiObject.DynamoDBv2 = expandDynamoDBv2Action(v)
+	}
+
+	if v, ok := tfMap["firehose"].([]interface{}); ok {
+		apiObject.Firehose = expandFirehoseAction(v)
+	}
+
+	if v, ok := tfMap["iot_events"].([]interface{}); ok {
+		apiObject.IotEvents = expandIoTEventsAction(v)
+	}
+
+	if v, ok := tfMap["iot_site_wise"].([]interface{}); ok {
+		apiObject.IotSiteWise = expandIoTSiteWiseAction(v)
+	}
+
+	if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok {
+		apiObject.IotTopicPublish = expandIoTTopicPublishAction(v)
+	}
+
+	if v, ok := tfMap["lambda"].([]interface{}); ok {
+		apiObject.Lambda = expandLambdaAction(v)
+	}
+
+	if v, ok := tfMap["sns"].([]interface{}); ok {
+		apiObject.Sns = expandSNSTopicPublishAction(v)
+	}
+
+	if v, ok := tfMap["sqs"].([]interface{}); ok {
+		apiObject.Sqs = expandSQSAction(v)
+	}
+
+	return apiObject
+}
+
+func expandAlarmActions(tfList []interface{}) []*iotevents.AlarmAction {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.AlarmAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAlarmAction(tfMap))
+	}
+
+	return apiObjects
+}
+
+func flattenPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ContentExpression; v != nil {
+		tfMap["content_expression"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenClearTimerAction(apiObject *iotevents.ClearTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TimerName; v != nil {
+		tfMap["timer_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDynamoDBAction(apiObject *iotevents.DynamoDBAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HashKeyField; v != nil {
+		tfMap["hash_key_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HashKeyType; v != nil {
+		tfMap["hash_key_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HashKeyValue; v != nil {
+		tfMap["hash_key_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Operation; v != nil {
+		tfMap["operation"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenPayload(v)
+	}
+
+	if v := apiObject.PayloadField; v != nil {
+		tfMap["payload_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyField; v != nil {
+		tfMap["range_key_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyType; v != nil {
+		tfMap["range_key_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyValue; v != nil {
+		tfMap["range_key_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDynamoDBv2Action(apiObject *iotevents.DynamoDBv2Action) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenPayload(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFirehoseAction(apiObject *iotevents.FirehoseAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DeliveryStreamName; v != nil {
+		tfMap["delivery_stream_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenPayload(v)
+	}
+
+	if v := apiObject.Separator; v != nil {
+		tfMap["separator"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTEventsAction(apiObject *iotevents.Action) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InputName; v != nil {
+		tfMap["input_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenPayload(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTSiteWiseAction(apiObject *iotevents.IotSiteWiseAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AssetId; v != nil {
+		tfMap["asset_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EntryId; v != nil {
+		tfMap["entry_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyAlias; v != nil {
+		tfMap["property_alias"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyId; v != nil {
+		tfMap["property_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyValue; v != nil {
+		tfMap["property_value"] = flattenAssetPropertyValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAssetPropertyValue(apiObject *iotevents.AssetPropertyValue) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Quality; v != nil {
+		tfMap["quality"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = []interface{}{map[string]interface{}{
+			"offset_in_nanos": aws.StringValue(v.OffsetInNanos),
+			"time_in_seconds": aws.StringValue(v.TimeInSeconds),
+		}}
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = []interface{}{map[string]interface{}{
+			"boolean_value": aws.StringValue(v.BooleanValue),
+			"double_value":  aws.StringValue(v.DoubleValue),
+			"integer_value": aws.StringValue(v.IntegerValue),
+			"string_value":  aws.StringValue(v.StringValue),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTTopicPublishAction(apiObject *iotevents.IotTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MqttTopic; v != nil {
+		tfMap["mqtt_topic"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenPayload(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLambdaAction(apiObject *iotevents.LambdaAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FunctionArn; v != nil {
+		tfMap["function_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenPayload(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenResetTimerAction(apiObject *iotevents.ResetTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TimerName; v != nil {
+		tfMap["timer_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSetTimerAction(apiObject *iotevents.SetTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DurationExpression; v != nil {
+		tfMap["duration_expression"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Seconds; v != nil {
+		tfMap["seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TimerName; v != nil {
+		tfMap["timer_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSetVariableAction(apiObject *iotevents.SetVariableAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VariableName; v != nil {
+		tfMap["variable_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSNSTopicPublishAction(apiObject *iotevents.SNSTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenPayload(v)
+	}
+
+	if v := apiObject.TargetArn; v != nil {
+		tfMap["target_arn"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSQSAction(apiObject *iotevents.SqsAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenPayload(v)
+	}
+
+	if v := apiObject.QueueUrl; v != nil {
+		tfMap["queue_url"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UseBase64; v != nil {
+		tfMap["use_base64"] = aws.BoolValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenActionData(apiObject *iotevents.ActionData) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ClearTimer; v != nil {
+		tfMap["clear_timer"] = flattenClearTimerAction(v)
+	}
+
+	if v := apiObject.DynamoDB; v != nil {
+		tfMap["dynamodb"] = flattenDynamoDBAction(v)
+	}
+
+	if v := apiObject.DynamoDBv2; v != nil {
+		tfMap["dynamodbv2"] = flattenDynamoDBv2Action(v)
+	}
+
+	if v := apiObject.Firehose; v != nil {
+		tfMap["firehose"] = flattenFirehoseAction(v)
+	}
+
+	if v := apiObject.IotEvents; v != nil {
+		tfMap["iot_events"] = flattenIoTEventsAction(v)
+	}
+
+	if v := apiObject.IotSiteWise; v != nil {
+		tfMap["iot_site_wise"] = flattenIoTSiteWiseAction(v)
+	}
+
+	if v := apiObject.IotTopicPublish; v != nil {
+		tfMap["iot_topic_publish"] = flattenIoTTopicPublishAction(v)
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		tfMap["lambda"] = flattenLambdaAction(v)
+	}
+
+	if v := apiObject.ResetTimer; v != nil {
+		tfMap["reset_timer"] = flattenResetTimerAction(v)
+	}
+
+	if v := apiObject.SetTimer; v != nil {
+		tfMap["set_timer"] = flattenSetTimerAction(v)
+	}
+
+	if v := apiObject.SetVariable; v != nil {
+		tfMap["set_variable"] = flattenSetVariableAction(v)
+	}
+
+	if v := apiObject.Sns; v != nil {
+		tfMap["sns"] = flattenSNSTopicPublishAction(v)
+	}
+
+	if v := apiObject.Sqs; v != nil {
+		tfMap["sqs"] = flattenSQSAction(v)
+	}
+
+	return tfMap
+}
+
+func flattenActionDatas(apiObjects []*iotevents.ActionData) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenActionData(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenAlarmAction(apiObject *iotevents.AlarmAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DynamoDB; v != nil {
+		tfMap["dynamodb"] = flattenDynamoDBAction(v)
+	}
+
+	if v := apiObject.DynamoDBv2; v != nil {
+		tfMap["dynamodbv2"] = flattenDynamoDBv2Action(v)
+	}
+
+	if v := apiObject.Firehose; v != nil {
+		tfMap["firehose"] = flattenFirehoseAction(v)
+	}
+
+	if v := apiObject.IotEvents; v != nil {
+		tfMap["iot_events"] = flattenIoTEventsAction(v)
+	}
+
+	if v := apiObject.IotSiteWise; v != nil {
+		tfMap["iot_site_wise"] = flattenIoTSiteWiseAction(v)
+	}
+
+	if v := apiObject.IotTopicPublish; v != nil {
+		tfMap["iot_topic_publish"] = flattenIoTTopicPublishAction(v)
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		tfMap["lambda"] = flattenLambdaAction(v)
+	}
+
+	if v := apiObject.Sns; v != nil {
+		tfMap["sns"] = flattenSNSTopicPublishAction(v)
+	}
+
+	if v := apiObject.Sqs; v != nil {
+		tfMap["sqs"] = flattenSQSAction(v)
+	}
+
+	return tfMap
+}
+
+func flattenAlarmActions(apiObjects []*iotevents.AlarmAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAlarmAction(apiObject))
+	}
+
+	return tfList
+}
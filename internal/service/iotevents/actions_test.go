@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+)
+
+func testPayload() *iotevents.Payload {
+	return &iotevents.Payload{
+		ContentExpression: aws.String("'{\"temperature\": ${$input.TemperatureInput.temperature}}'"),
+		Type:              aws.String(iotevents.PayloadTypeJson),
+	}
+}
+
+func testDynamoDBAction() *iotevents.DynamoDBAction {
+	return &iotevents.DynamoDBAction{
+		HashKeyField:  aws.String("hash"),
+		HashKeyType:   aws.String("STRING"),
+		HashKeyValue:  aws.String("$input.TemperatureInput.sensorId"),
+		Operation:     aws.String("INSERT"),
+		Payload:       testPayload(),
+		PayloadField:  aws.String("payload"),
+		RangeKeyField: aws.String("range"),
+		RangeKeyType:  aws.String("NUMBER"),
+		RangeKeyValue: aws.String("$input.TemperatureInput.timestamp"),
+		TableName:     aws.String("readings"),
+	}
+}
+
+func testDynamoDBv2Action() *iotevents.DynamoDBv2Action {
+	return &iotevents.DynamoDBv2Action{
+		Payload:   testPayload(),
+		TableName: aws.String("readings"),
+	}
+}
+
+func testFirehoseAction() *iotevents.FirehoseAction {
+	return &iotevents.FirehoseAction{
+		DeliveryStreamName: aws.String("readings"),
+		Payload:            testPayload(),
+		Separator:          aws.String("\n"),
+	}
+}
+
+func testIoTEventsAction() *iotevents.Action {
+	return &iotevents.Action{
+		InputName: aws.String("TemperatureInput"),
+		Payload:   testPayload(),
+	}
+}
+
+func testIoTSiteWiseAction() *iotevents.IotSiteWiseAction {
+	return &iotevents.IotSiteWiseAction{
+		AssetId:    aws.String("a1b2c3d4-5678-90ab-cdef-11111EXAMPLE"),
+		EntryId:    aws.String("entry"),
+		PropertyId: aws.String("a1b2c3d4-5678-90ab-cdef-22222EXAMPLE"),
+		PropertyValue: &iotevents.AssetPropertyValue{
+			Quality: aws.String("GOOD"),
+			Timestamp: &iotevents.AssetPropertyTimestamp{
+				OffsetInNanos: aws.String("0"),
+				TimeInSeconds: aws.String("$input.TemperatureInput.timestamp"),
+			},
+			Value: &iotevents.AssetPropertyVariant{
+				DoubleValue: aws.String("$input.TemperatureInput.temperature"),
+			},
+		},
+	}
+}
+
+func testIoTTopicPublishAction() *iotevents.IotTopicPublishAction {
+	return &iotevents.IotTopicPublishAction{
+		MqttTopic: aws.String("readings/temperature"),
+		Payload:   testPayload(),
+	}
+}
+
+func testLambdaAction() *iotevents.LambdaAction {
+	return &iotevents.LambdaAction{
+		FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:readings"), //lintignore:AWSAT003,AWSAT005
+		Payload:     testPayload(),
+	}
+}
+
+func testSNSTopicPublishAction() *iotevents.SNSTopicPublishAction {
+	return &iotevents.SNSTopicPublishAction{
+		Payload:   testPayload(),
+		TargetArn: aws.String("arn:aws:sns:us-west-2:123456789012:readings"), //lintignore:AWSAT003,AWSAT005
+	}
+}
+
+func testSQSAction() *iotevents.SqsAction {
+	return &iotevents.SqsAction{
+		Payload:   testPayload(),
+		QueueUrl:  aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/readings"), //lintignore:AWSAT003
+		UseBase64: aws.Bool(true),
+	}
+}
+
+func testDetectorModelDefinition(action *iotevents.ActionData) *iotevents.DetectorModelDefinition {
+	return &iotevents.DetectorModelDefinition{
+		InitialStateName: aws.String("normal"),
+		States: []*iotevents.State{
+			{
+				OnEnter: &iotevents.OnEnterLifecycle{
+					Events: []*iotevents.Event{{
+						Actions:   []*iotevents.ActionData{action},
+						Condition: aws.String("true"),
+						EventName: aws.String("enter"),
+					}},
+				},
+				OnExit: &iotevents.OnExitLifecycle{
+					Events: []*iotevents.Event{{
+						Actions:   []*iotevents.ActionData{action},
+						EventName: aws.String("exit"),
+					}},
+				},
+				OnInput: &iotevents.OnInputLifecycle{
+					Events: []*iotevents.Event{{
+						Actions:   []*iotevents.ActionData{action},
+						EventName: aws.String("input"),
+					}},
+					TransitionEvents: []*iotevents.TransitionEvent{{
+						Actions:   []*iotevents.ActionData{action},
+						Condition: aws.String("$input.TemperatureInput.temperature > 40"),
+						EventName: aws.String("overheat"),
+						NextState: aws.String("alarm"),
+					}},
+				},
+				StateName: aws.String("normal"),
+			},
+			{
+				StateName: aws.String("alarm"),
+			},
+		},
+	}
+}
+
+func TestDetectorModelDefinitionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*iotevents.ActionData{
+		"clear_timer": {
+			ClearTimer: &iotevents.ClearTimerAction{TimerName: aws.String("heartbeat")},
+		},
+		"dynamodb": {
+			DynamoDB: testDynamoDBAction(),
+		},
+		"dynamodbv2": {
+			DynamoDBv2: testDynamoDBv2Action(),
+		},
+		"firehose": {
+			Firehose: testFirehoseAction(),
+		},
+		"iot_events": {
+			IotEvents: testIoTEventsAction(),
+		},
+		"iot_site_wise": {
+			IotSiteWise: testIoTSiteWiseAction(),
+		},
+		"iot_topic_publish": {
+			IotTopicPublish: testIoTTopicPublishAction(),
+		},
+		"lambda": {
+			Lambda: testLambdaAction(),
+		},
+		"reset_timer": {
+			ResetTimer: &iotevents.ResetTimerAction{TimerName: aws.String("heartbeat")},
+		},
+		"set_timer seconds": {
+			SetTimer: &iotevents.SetTimerAction{
+				Seconds:   aws.Int64(60),
+				TimerName: aws.String("heartbeat"),
+			},
+		},
+		"set_timer duration_expression": {
+			SetTimer: &iotevents.SetTimerAction{
+				DurationExpression: aws.String("$variable.heartbeatSeconds"),
+				TimerName:          aws.String("heartbeat"),
+			},
+		},
+		"set_variable": {
+			SetVariable: &iotevents.SetVariableAction{
+				Value:        aws.String("$input.TemperatureInput.temperature"),
+				VariableName: aws.String("lastTemperature"),
+			},
+		},
+		"sns": {
+			Sns: testSNSTopicPublishAction(),
+		},
+		"sqs": {
+			Sqs: testSQSAction(),
+		},
+	}
+
+	for name, action := range testCases {
+		name, action := name, action
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			want := testDetectorModelDefinition(action)
+
+			// Round-trip through the resource's schema, as Read and Create/Update do.
+			d := ResourceDetectorModel().Data(nil)
+			if err := d.Set("definition", flattenDetectorModelDefinition(want)); err != nil {
+				t.Fatalf("setting definition: %s", err)
+			}
+
+			if got := expandDetectorModelDefinition(d.Get("definition").([]interface{})); !reflect.DeepEqual(got, want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAlarmModelRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*iotevents.AlarmAction{
+		"dynamodb": {
+			DynamoDB: testDynamoDBAction(),
+		},
+		"dynamodbv2": {
+			DynamoDBv2: testDynamoDBv2Action(),
+		},
+		"firehose": {
+			Firehose: testFirehoseAction(),
+		},
+		"iot_events": {
+			IotEvents: testIoTEventsAction(),
+		},
+		"iot_site_wise": {
+			IotSiteWise: testIoTSiteWiseAction(),
+		},
+		"iot_topic_publish": {
+			IotTopicPublish: testIoTTopicPublishAction(),
+		},
+		"lambda": {
+			Lambda: testLambdaAction(),
+		},
+		"sns": {
+			Sns: testSNSTopicPublishAction(),
+		},
+		"sqs": {
+			Sqs: testSQSAction(),
+		},
+	}
+
+	recipients := []*iotevents.RecipientDetail{{
+		SsoIdentity: &iotevents.SSOIdentity{
+			IdentityStoreId: aws.String("d-1234567890"),
+			UserId:          aws.String("a1b2c3d4-5678-90ab-cdef-33333EXAMPLE"),
+		},
+	}}
+	wantCapabilities := &iotevents.AlarmCapabilities{
+		AcknowledgeFlow: &iotevents.AcknowledgeFlow{
+			Enabled: aws.Bool(true),
+		},
+		InitializationConfiguration: &iotevents.InitializationConfiguration{
+			DisabledOnInitialization: aws.Bool(false),
+		},
+	}
+	wantNotification := &iotevents.AlarmNotification{
+		NotificationActions: []*iotevents.NotificationAction{{
+			Action: &iotevents.NotificationTargetActions{
+				LambdaAction: testLambdaAction(),
+			},
+			EmailConfigurations: []*iotevents.EmailConfiguration{{
+				Content: &iotevents.EmailContent{
+					AdditionalMessage: aws.String("Temperature is too high."),
+					Subject:           aws.String("Overheat"),
+				},
+				From: aws.String("alarms@example.com"),
+				Recipients: &iotevents.EmailRecipients{
+					To: recipients,
+				},
+			}},
+			SmsConfigurations: []*iotevents.SMSConfiguration{{
+				AdditionalMessage: aws.String("Temperature is too high."),
+				Recipients:        recipients,
+				SenderId:          aws.String("alarms"),
+			}},
+		}},
+	}
+	wantRule := &iotevents.AlarmRule{
+		SimpleRule: &iotevents.SimpleRule{
+			ComparisonOperator: aws.String(iotevents.ComparisonOperatorGreater),
+			InputProperty:      aws.String("$input.TemperatureInput.temperature"),
+			Threshold:          aws.String("40"),
+		},
+	}
+
+	for name, action := range testCases {
+		name, action := name, action
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			wantEventActions := &iotevents.AlarmEventActions{
+				AlarmActions: []*iotevents.AlarmAction{action},
+			}
+
+			// Round-trip through the resource's schema, as Read and Create/Update do.
+			d := ResourceAlarmModel().Data(nil)
+			if err := d.Set("alarm_capabilities", flattenAlarmCapabilities(wantCapabilities)); err != nil {
+				t.Fatalf("setting alarm_capabilities: %s", err)
+			}
+			if err := d.Set("alarm_event_actions", flattenAlarmEventActions(wantEventActions)); err != nil {
+				t.Fatalf("setting alarm_event_actions: %s", err)
+			}
+			if err := d.Set("alarm_notification", flattenAlarmNotification(wantNotification)); err != nil {
+				t.Fatalf("setting alarm_notification: %s", err)
+			}
+			if err := d.Set("alarm_rule", flattenAlarmRule(wantRule)); err != nil {
+				t.Fatalf("setting alarm_rule: %s", err)
+			}
+
+			if got := expandAlarmCapabilities(d.Get("alarm_capabilities").([]interface{})); !reflect.DeepEqual(got, wantCapabilities) {
+				t.Errorf("alarm_capabilities: got %s, want %s", got, wantCapabilities)
+			}
+			if got := expandAlarmEventActions(d.Get("alarm_event_actions").([]interface{})); !reflect.DeepEqual(got, wantEventActions) {
+				t.Errorf("alarm_event_actions: got %s, want %s", got, wantEventActions)
+			}
+			if got := expandAlarmNotification(d.Get("alarm_notification").([]interface{})); !reflect.DeepEqual(got, wantNotification) {
+				t.Errorf("alarm_notification: got %s, want %s", got, wantNotification)
+			}
+			if got := expandAlarmRule(d.Get("alarm_rule").([]interface{})); !reflect.DeepEqual(got, wantRule) {
+				t.Errorf("alarm_rule: got %s, want %s", got, wantRule)
+			}
+		})
+	}
+}
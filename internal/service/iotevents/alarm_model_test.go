@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTEventsAlarmModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_notification.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "GREATER"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "80"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("alarmModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceAlarmModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_actions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAlarmModelConfig_actions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.acknowledge_flow.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.initialization_configuration.0.disabled_on_initialization", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.0.alarm_action.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "alarm_event_actions.0.alarm_action.0.sns.0.target_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAlarmModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_alarm_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Alarm Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAlarmModelExists(ctx context.Context, n string, v *iotevents.DescribeAlarmModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Alarm Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		output, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAlarmModelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = "80"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccAlarmModelConfig_actions(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotevents_alarm_model" "test" {
+  name        = %[1]q
+  description = "updated"
+  role_arn    = aws_iam_role.test.arn
+  severity    = 2
+
+  alarm_capabilities {
+    acknowledge_flow {
+      enabled = false
+    }
+
+    initialization_configuration {
+      disabled_on_initialization = true
+    }
+  }
+
+  alarm_event_actions {
+    alarm_action {
+      sns {
+        target_arn = aws_sns_topic.test.arn
+      }
+    }
+  }
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = "80"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -0,0 +1,682 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_detector_model", name="Detector Model")
+// @Tags(identifierAttribute="arn")
+func ResourceDetectorModel() *schema.Resource {
+	eventSchema := func(transition bool) *schema.Resource {
+		s := map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: detectorActionSchemaMap(),
+				},
+			},
+			"condition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"event_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		}
+
+		if transition {
+			s["condition"].Optional = false
+			s["condition"].Required = true
+			s["next_state"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			}
+		}
+
+		return &schema.Resource{
+			Schema: s,
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorModelCreate,
+		ReadWithoutTimeout:   resourceDetectorModelRead,
+		UpdateWithoutTimeout: resourceDetectorModelUpdate,
+		DeleteWithoutTimeout: resourceDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_state_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"state": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_enter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     eventSchema(false),
+												},
+											},
+										},
+									},
+									"on_exit": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     eventSchema(false),
+												},
+											},
+										},
+									},
+									"on_input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     eventSchema(false),
+												},
+												"transition_event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     eventSchema(true),
+												},
+											},
+										},
+									},
+									"state_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDetectorModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: expandDetectorModelDefinition(d.Get("definition").([]interface{})),
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateDetectorModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := FindDetectorModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	d.Set("arn", configuration.DetectorModelArn)
+	if err := d.Set("definition", flattenDetectorModelDefinition(output.DetectorModelDefinition)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting definition: %s", err)
+	}
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	return diags
+}
+
+func resourceDetectorModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition: expandDetectorModelDefinition(d.Get("definition").([]interface{})),
+			DetectorModelName:       aws.String(d.Id()),
+			RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.DetectorModelDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateDetectorModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModelWithContext(ctx, &iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDetectorModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDetectorModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DetectorModel, nil
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDetectorModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDetectorModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: iotevents.DetectorModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDetectorModelDefinition(tfList []interface{}) *iotevents.DetectorModelDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.DetectorModelDefinition{}
+
+	if v, ok := tfMap["initial_state_name"].(string); ok && v != "" {
+		apiObject.InitialStateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["state"].([]interface{}); ok && len(v) > 0 {
+		apiObject.States = expandStates(v)
+	}
+
+	return apiObject
+}
+
+func expandStates(tfList []interface{}) []*iotevents.State {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.State
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.State{}
+
+		if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.OnEnter = &iotevents.OnEnterLifecycle{}
+
+			if v, ok := tfMap["event"].([]interface{}); ok && len(v) > 0 {
+				apiObject.OnEnter.Events = expandEvents(v)
+			}
+		}
+
+		if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.OnExit = &iotevents.OnExitLifecycle{}
+
+			if v, ok := tfMap["event"].([]interface{}); ok && len(v) > 0 {
+				apiObject.OnExit.Events = expandEvents(v)
+			}
+		}
+
+		if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.OnInput = &iotevents.OnInputLifecycle{}
+
+			if v, ok := tfMap["event"].([]interface{}); ok && len(v) > 0 {
+				apiObject.OnInput.Events = expandEvents(v)
+			}
+
+			if v, ok := tfMap["transition_event"].([]interface{}); ok && len(v) > 0 {
+				apiObject.OnInput.TransitionEvents = expandTransitionEvents(v)
+			}
+		}
+
+		if v, ok := tfMap["state_name"].(string); ok && v != "" {
+			apiObject.StateName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEvents(tfList []interface{}) []*iotevents.Event {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.Event{}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Actions = expandActionDatas(v)
+		}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		if v, ok := tfMap["event_name"].(string); ok && v != "" {
+			apiObject.EventName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.TransitionEvent{}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Actions = expandActionDatas(v)
+		}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		if v, ok := tfMap["event_name"].(string); ok && v != "" {
+			apiObject.EventName = aws.String(v)
+		}
+
+		if v, ok := tfMap["next_state"].(string); ok && v != "" {
+			apiObject.NextState = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InitialStateName; v != nil {
+		tfMap["initial_state_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.States; v != nil {
+		tfMap["state"] = flattenStates(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStates(apiObjects []*iotevents.State) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.OnEnter; v != nil {
+			tfMap["on_enter"] = []interface{}{map[string]interface{}{
+				"event": flattenEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnExit; v != nil {
+			tfMap["on_exit"] = []interface{}{map[string]interface{}{
+				"event": flattenEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnInput; v != nil {
+			tfMap["on_input"] = []interface{}{map[string]interface{}{
+				"event":            flattenEvents(v.Events),
+				"transition_event": flattenTransitionEvents(v.TransitionEvents),
+			}}
+		}
+
+		if v := apiObject.StateName; v != nil {
+			tfMap["state_name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEvents(apiObjects []*iotevents.Event) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Actions; v != nil {
+			tfMap["action"] = flattenActionDatas(v)
+		}
+
+		if v := apiObject.Condition; v != nil {
+			tfMap["condition"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.EventName; v != nil {
+			tfMap["event_name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Actions; v != nil {
+			tfMap["action"] = flattenActionDatas(v)
+		}
+
+		if v := apiObject.Condition; v != nil {
+			tfMap["condition"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.EventName; v != nil {
+			tfMap["event_name"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.NextState; v != nil {
+			tfMap["next_state"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
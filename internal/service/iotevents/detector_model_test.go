@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTEventsDetectorModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.initial_state_name", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.state_name", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_enter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_enter.0.event.0.event_name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_enter.0.event.0.action.0.set_variable.0.variable_name", "threshold"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.transition_event.0.next_state", "alarm"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.state_name", "alarm"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.0.set_timer.0.timer_name", "cooldown"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_exit.0.event.0.action.0.clear_timer.0.timer_name", "cooldown"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "BATCH"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.event.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.event.0.action.0.iot_topic_publish.0.mqtt_topic", "alerts/temperature"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.event.0.action.0.iot_topic_publish.0.payload.0.type", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_detector_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorModelExists(ctx context.Context, n string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		output, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["iot:Publish", "sns:Publish"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  attribute {
+    json_path = "temperature"
+  }
+
+  attribute {
+    json_path = "sensorId"
+  }
+}
+`, rName)
+}
+
+func testAccDetectorModelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+
+      on_enter {
+        event {
+          event_name = "init"
+          condition  = "true"
+
+          action {
+            set_variable {
+              variable_name = "threshold"
+              value         = "80"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "overheated"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > $variable.threshold"
+          next_state = "alarm"
+        }
+      }
+    }
+
+    state {
+      state_name = "alarm"
+
+      on_enter {
+        event {
+          event_name = "start_cooldown"
+          condition  = "true"
+
+          action {
+            set_timer {
+              timer_name          = "cooldown"
+              duration_expression = "300"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "cooled_down"
+          condition  = "timeout(\"cooldown\")"
+          next_state = "normal"
+        }
+      }
+
+      on_exit {
+        event {
+          event_name = "stop_cooldown"
+          condition  = "true"
+
+          action {
+            clear_timer {
+              timer_name = "cooldown"
+            }
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name        = %[1]q
+  description = "updated"
+  role_arn    = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+
+      on_enter {
+        event {
+          event_name = "init"
+          condition  = "true"
+
+          action {
+            set_variable {
+              variable_name = "threshold"
+              value         = "90"
+            }
+          }
+        }
+      }
+
+      on_input {
+        event {
+          event_name = "report"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > 50"
+
+          action {
+            iot_topic_publish {
+              mqtt_topic = "alerts/temperature"
+
+              payload {
+                content_expression = "'{\"temperature\": $${$input.${aws_iotevents_input.test.name}.temperature}}'"
+                type               = "JSON"
+              }
+            }
+          }
+        }
+
+        transition_event {
+          event_name = "overheated"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > $variable.threshold"
+          next_state = "alarm"
+        }
+      }
+    }
+
+    state {
+      state_name = "alarm"
+
+      on_enter {
+        event {
+          event_name = "start_cooldown"
+          condition  = "true"
+
+          action {
+            set_timer {
+              timer_name          = "cooldown"
+              duration_expression = "300"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "cooled_down"
+          condition  = "timeout(\"cooldown\")"
+          next_state = "normal"
+        }
+      }
+
+      on_exit {
+        event {
+          event_name = "stop_cooldown"
+          condition  = "true"
+
+          action {
+            clear_timer {
+              timer_name = "cooldown"
+            }
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
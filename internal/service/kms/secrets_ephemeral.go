@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Secrets")
+func newEphemeralSecrets(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSecrets{}, nil
+}
+
+type ephemeralSecrets struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSecrets) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_kms_secrets"
+}
+
+func (e *ephemeralSecrets) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"plaintext": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"secret": schema.SetNestedBlock{
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"context": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"encryption_algorithm": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(kms.EncryptionAlgorithmSpec_Values()...),
+							},
+						},
+						"grant_tokens": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"key_id": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"payload": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralSecretsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var secrets []ephemeralSecretData
+
+	response.Diagnostics.Append(data.Secrets.ElementsAs(ctx, &secrets, false)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().KMSConn(ctx)
+	plaintext := make(map[string]string, len(secrets))
+
+	for _, secret := range secrets {
+		name := secret.Name.ValueString()
+		payload, err := base64.StdEncoding.DecodeString(secret.Payload.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("invalid base64 value for secret (%s)", name), err.Error())
+
+			return
+		}
+
+		input := &kms.DecryptInput{
+			CiphertextBlob: payload,
+		}
+
+		if v := flex.ExpandFrameworkStringValueMap(ctx, secret.Context); len(v) > 0 {
+			input.EncryptionContext = aws.StringMap(v)
+		}
+
+		if v := secret.EncryptionAlgorithm.ValueString(); v != "" {
+			input.EncryptionAlgorithm = aws.String(v)
+		}
+
+		if v := flex.ExpandFrameworkStringList(ctx, secret.GrantTokens); len(v) > 0 {
+			input.GrantTokens = v
+		}
+
+		if v := secret.KeyID.ValueString(); v != "" {
+			input.KeyId = aws.String(v)
+		}
+
+		output, err := conn.DecryptWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("decrypting secret (%s)", name), err.Error())
+
+			return
+		}
+
+		plaintext[name] = string(output.Plaintext)
+	}
+
+	data.Plaintext = flex.FlattenFrameworkStringValueMapLegacy(ctx, plaintext)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralSecretsData struct {
+	Plaintext types.Map `tfsdk:"plaintext"`
+	Secrets   types.Set `tfsdk:"secret"`
+}
+
+type ephemeralSecretData struct {
+	Context             types.Map    `tfsdk:"context"`
+	EncryptionAlgorithm types.String `tfsdk:"encryption_algorithm"`
+	GrantTokens         types.List   `tfsdk:"grant_tokens"`
+	KeyID               types.String `tfsdk:"key_id"`
+	Name                types.String `tfsdk:"name"`
+	Payload             types.String `tfsdk:"payload"`
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSSecretsEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	plaintext := "my-plaintext-string"
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, kms.EndpointsID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretsEphemeralConfig_basic(plaintext),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("plaintext").AtMapKey("secret1"), knownvalue.StringExact(plaintext)),
+				},
+			},
+		},
+	})
+}
+
+func testAccSecretsEphemeralConfig_basic(plaintext string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_kms_secrets.test"),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+  description             = "Testing the Terraform AWS KMS Secrets ephemeral resource"
+}
+
+resource "aws_kms_ciphertext" "test" {
+  key_id    = aws_kms_key.test.key_id
+  plaintext = %[1]q
+
+  context = {
+    name = "value"
+  }
+}
+
+ephemeral "aws_kms_secrets" "test" {
+  secret {
+    name    = "secret1"
+    payload = aws_kms_ciphertext.test.ciphertext_blob
+
+    context = {
+      name = "value"
+    }
+  }
+}
+`, plaintext))
+}
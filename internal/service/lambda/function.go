@@ -232,6 +232,39 @@ func ResourceFunction() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.ApplicationLogLevel](),
+						},
+						"log_format": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.LogFormat](),
+						},
+						"log_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"system_log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.SystemLogLevel](),
+						},
+					},
+				},
+			},
 			"memory_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -520,6 +553,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Layers = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoggingConfig = expandLoggingConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("snap_start"); ok {
 		input.SnapStart = expandSnapStart(v.([]interface{}))
 	}
@@ -638,6 +675,9 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting layers: %s", err)
 	}
+	if err := d.Set("logging_config", flattenLoggingConfig(function.LoggingConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting logging_config: %s", err)
+	}
 	d.Set("memory_size", function.MemorySize)
 	d.Set("package_type", function.PackageType)
 	if output.Concurrency != nil {
@@ -821,6 +861,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.Layers = flex.ExpandStringValueList(d.Get("layers").([]interface{}))
 		}
 
+		if d.HasChange("logging_config") {
+			input.LoggingConfig = expandLoggingConfig(d.Get("logging_config").([]interface{}))
+		}
+
 		if d.HasChange("memory_size") {
 			input.MemorySize = aws.Int32(int32(d.Get("memory_size").(int)))
 		}
@@ -1247,6 +1291,7 @@ func needsFunctionConfigUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("timeout") ||
 		d.HasChange("kms_key_arn") ||
 		d.HasChange("layers") ||
+		d.HasChange("logging_config") ||
 		d.HasChange("dead_letter_config") ||
 		d.HasChange("snap_start") ||
 		d.HasChange("tracing_config") ||
@@ -1389,6 +1434,49 @@ func flattenEphemeralStorage(response *types.EphemeralStorage) []map[string]inte
 	return []map[string]interface{}{m}
 }
 
+func expandLoggingConfig(tfList []interface{}) *types.LoggingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.LoggingConfig{
+		LogFormat: types.LogFormat(tfMap["log_format"].(string)),
+	}
+
+	// Log levels can only be set for JSON formatted logs.
+	if apiObject.LogFormat == types.LogFormatJson {
+		if v, ok := tfMap["application_log_level"].(string); ok && v != "" {
+			apiObject.ApplicationLogLevel = types.ApplicationLogLevel(v)
+		}
+
+		if v, ok := tfMap["system_log_level"].(string); ok && v != "" {
+			apiObject.SystemLogLevel = types.SystemLogLevel(v)
+		}
+	}
+
+	if v, ok := tfMap["log_group"].(string); ok && v != "" {
+		apiObject.LogGroup = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLoggingConfig(apiObject *types.LoggingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"application_log_level": string(apiObject.ApplicationLogLevel),
+		"log_format":            string(apiObject.LogFormat),
+		"log_group":             aws.ToString(apiObject.LogGroup),
+		"system_log_level":      string(apiObject.SystemLogLevel),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandSnapStart(tfList []interface{}) *types.SnapStart {
 	snapStart := &types.SnapStart{ApplyOn: types.SnapStartApplyOnNone}
 	if len(tfList) == 1 && tfList[0] != nil {
@@ -22,6 +22,8 @@ import (
 )
 
 // @SDKResource("aws_lambda_provisioned_concurrency_config")
+// @IdentityAttribute("function_name")
+// @IdentityAttribute("qualifier")
 func ResourceProvisionedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProvisionedConcurrencyConfigCreate,
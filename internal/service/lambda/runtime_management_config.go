@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lambda_runtime_management_config", name="Runtime Management Config")
+func ResourceRuntimeManagementConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuntimeManagementConfigPut,
+		ReadWithoutTimeout:   resourceRuntimeManagementConfigRead,
+		UpdateWithoutTimeout: resourceRuntimeManagementConfigPut,
+		DeleteWithoutTimeout: resourceRuntimeManagementConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: checkRuntimeManagementConfigRuntimeVersion,
+
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"runtime_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"update_runtime_on": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.UpdateRuntimeOnAuto,
+				ValidateDiagFunc: enum.Validate[types.UpdateRuntimeOn](),
+			},
+		},
+	}
+}
+
+const (
+	runtimeManagementConfigIDPartCount = 2
+)
+
+func resourceRuntimeManagementConfigPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id, err := flex.FlattenResourceId([]string{functionName, qualifier}, runtimeManagementConfigIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: types.UpdateRuntimeOn(d.Get("update_runtime_on").(string)),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("runtime_version_arn"); ok {
+		input.RuntimeVersionArn = aws.String(v.(string))
+	}
+
+	_, err = retryFunctionOp(ctx, func() (interface{}, error) {
+		return conn.PutRuntimeManagementConfig(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Lambda Runtime Management Config (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceRuntimeManagementConfigRead(ctx, d, meta)...)
+}
+
+func resourceRuntimeManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), runtimeManagementConfigIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	functionName, qualifier := parts[0], parts[1]
+	output, err := FindRuntimeManagementConfigByTwoPartKey(ctx, conn, functionName, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Runtime Management Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", functionName)
+	d.Set("qualifier", qualifier)
+	d.Set("runtime_version_arn", output.RuntimeVersionArn)
+	d.Set("update_runtime_on", output.UpdateRuntimeOn)
+
+	return diags
+}
+
+func resourceRuntimeManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), runtimeManagementConfigIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	functionName, qualifier := parts[0], parts[1]
+	// There is no API to remove a runtime management configuration, so deleting the resource
+	// hands runtime updates back to Lambda.
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: types.UpdateRuntimeOnAuto,
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Deleting Lambda Runtime Management Config: %s", d.Id())
+	_, err = retryFunctionOp(ctx, func() (interface{}, error) {
+		return conn.PutRuntimeManagementConfig(ctx, input)
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRuntimeManagementConfigByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, qualifier string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// checkRuntimeManagementConfigRuntimeVersion requires a pinned runtime version in Manual mode and rejects one otherwise.
+func checkRuntimeManagementConfigRuntimeVersion(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	updateRuntimeOn := types.UpdateRuntimeOn(d.Get("update_runtime_on").(string))
+	runtimeVersionARN := d.Get("runtime_version_arn").(string)
+
+	if updateRuntimeOn == types.UpdateRuntimeOnManual && runtimeVersionARN == "" && d.NewValueKnown("runtime_version_arn") {
+		return fmt.Errorf("runtime_version_arn is required when update_runtime_on is %q", types.UpdateRuntimeOnManual)
+	}
+
+	if updateRuntimeOn != types.UpdateRuntimeOnManual && runtimeVersionARN != "" {
+		return fmt.Errorf("runtime_version_arn can only be set when update_runtime_on is %q", types.UpdateRuntimeOnManual)
+	}
+
+	return nil
+}
@@ -27,6 +27,8 @@ const (
 )
 
 // @SDKResource("aws_lightsail_bucket_resource_access")
+// @IdentityAttribute("bucket_name")
+// @IdentityAttribute("resource_name")
 func ResourceBucketResourceAccess() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketResourceAccessCreate,
@@ -0,0 +1,2225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+func ResourceFlow() *schema.Resource {
+	encryptionSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"algorithm": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(mediaconnect.Algorithm_Values(), false),
+					},
+					"constant_initialization_vector": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"device_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"key_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice(mediaconnect.KeyType_Values(), false),
+					},
+					"region": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"resource_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"role_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"secret_arn": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"url": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowCreate,
+		ReadWithoutTimeout:   resourceFlowRead,
+		UpdateWithoutTimeout: resourceFlowUpdate,
+		DeleteWithoutTimeout: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_transfer_subscriber_fee_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"encryption": encryptionSchema(),
+						"entitlement_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.EntitlementStatus_Values(), false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			"maintenance": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maintenance_day": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.MaintenanceDay_Values(), false),
+						},
+						"maintenance_start_hour": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"media_stream": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fmtp": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"channel_order": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"colorimetry": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.Colorimetry_Values(), false),
+												},
+												"exact_framerate": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"par": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"range": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.Range_Values(), false),
+												},
+												"scan_mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.ScanMode_Values(), false),
+												},
+												"tcs": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.Tcs_Values(), false),
+												},
+											},
+										},
+									},
+									"lang": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"clock_rate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"fmt": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"media_stream_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"media_stream_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"media_stream_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.MediaStreamType_Values(), false),
+						},
+						"video_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_allow_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidCIDRNetworkAddress,
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": encryptionSchema(),
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"media_stream_output_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_ip": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"destination_port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+												"interface_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"encoding_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(mediaconnect.EncodingName_Values(), false),
+									},
+									"encoding_parameters": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compression_factor": {
+													Type:     schema.TypeFloat,
+													Required: true,
+												},
+												"encoder_profile": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.EncoderProfile_Values(), false),
+												},
+											},
+										},
+									},
+									"media_stream_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"remote_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sender_control_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"smoothing_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decryption": encryptionSchema(),
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"entitlement_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"ingest_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingest_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_sync_buffer": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"media_stream_source_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encoding_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(mediaconnect.EncodingName_Values(), false),
+									},
+									"input_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+												"interface_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"media_stream_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"sender_control_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"sender_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_listener_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_listener_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"whitelist_cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"source_failover_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failover_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.FailoverMode_Values(), false),
+						},
+						"primary_source": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"recovery_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.State_Values(), false),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      mediaconnect.StatusStandby,
+				ValidateFunc: validation.StringInSlice([]string{mediaconnect.StatusActive, mediaconnect.StatusStandby}, false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_interface": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.NetworkInterfaceType_Values(), false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &mediaconnect.CreateFlowInput{
+		Name:    aws.String(name),
+		Sources: expandSetSourceRequests(d.Get("source").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement"); ok && len(v.([]interface{})) > 0 {
+		input.Entitlements = expandGrantEntitlementRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("maintenance"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.Maintenance = &mediaconnect.AddMaintenance{
+			MaintenanceDay:       aws.String(tfMap["maintenance_day"].(string)),
+			MaintenanceStartHour: aws.String(tfMap["maintenance_start_hour"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("media_stream"); ok && len(v.([]interface{})) > 0 {
+		input.MediaStreams = expandAddMediaStreamRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		input.Outputs = expandAddOutputRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_interface"); ok && len(v.([]interface{})) > 0 {
+		input.VpcInterfaces = expandVPCInterfaceRequests(v.([]interface{}))
+	}
+
+	output, err := conn.CreateFlowWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Flow.FlowArn))
+
+	if _, err := waitFlowStandby(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) create: %s", d.Id(), err)
+	}
+
+	if err := createTags(ctx, conn, d.Id(), getTagsIn(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting MediaConnect Flow (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := updateFlowState(ctx, conn, d.Id(), mediaconnect.StatusStandby, d.Get("state").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFlowRead(ctx, d, meta)...)
+}
+
+func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("availability_zone", flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+	if err := d.Set("entitlement", sortByName(flattenEntitlements(flow.Entitlements), d.Get("entitlement").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entitlement: %s", err)
+	}
+	if err := d.Set("maintenance", flattenMaintenance(flow.Maintenance)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting maintenance: %s", err)
+	}
+	if err := d.Set("media_stream", sortByMediaStreamName(flattenMediaStreams(flow.MediaStreams), d.Get("media_stream").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting media_stream: %s", err)
+	}
+	d.Set("name", flow.Name)
+	if err := d.Set("output", sortByName(flattenOutputs(flow.Outputs), d.Get("output").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output: %s", err)
+	}
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []*mediaconnect.Source{flow.Source}
+	}
+	if err := d.Set("source", sortByName(flattenSources(sources), d.Get("source").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	if err := d.Set("source_failover_config", flattenFailoverConfig(flow.SourceFailoverConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_failover_config: %s", err)
+	}
+	switch status := aws.StringValue(flow.Status); status {
+	case mediaconnect.StatusActive, mediaconnect.StatusStandby:
+		d.Set("state", status)
+	}
+	if err := d.Set("vpc_interface", sortByName(flattenVPCInterfaces(flow.VpcInterfaces), d.Get("vpc_interface").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_interface: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	if d.HasChangesExcept("state", "tags", "tags_all") {
+		flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+
+		// Most changes can only be made while the flow is stopped.
+		currentState := aws.StringValue(flow.Status)
+		if err := updateFlowState(ctx, conn, d.Id(), currentState, mediaconnect.StatusStandby, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+
+		if d.HasChanges("maintenance", "source_failover_config") {
+			input := &mediaconnect.UpdateFlowInput{
+				FlowArn: aws.String(d.Id()),
+			}
+
+			if d.HasChange("maintenance") {
+				if v, ok := d.GetOk("maintenance"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					tfMap := v.([]interface{})[0].(map[string]interface{})
+					input.Maintenance = &mediaconnect.UpdateMaintenance{
+						MaintenanceDay:       aws.String(tfMap["maintenance_day"].(string)),
+						MaintenanceStartHour: aws.String(tfMap["maintenance_start_hour"].(string)),
+					}
+				}
+			}
+
+			if d.HasChange("source_failover_config") {
+				if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					apiObject := expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+					input.SourceFailoverConfig = &mediaconnect.UpdateFailoverConfig{
+						FailoverMode:   apiObject.FailoverMode,
+						RecoveryWindow: apiObject.RecoveryWindow,
+						SourcePriority: apiObject.SourcePriority,
+						State:          apiObject.State,
+					}
+				}
+			}
+
+			if _, err := conn.UpdateFlowWithContext(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s): %s", d.Id(), err)
+			}
+		}
+
+		// VPC interfaces are added before, and removed after, the sources and outputs that use them.
+		if d.HasChange("vpc_interface") {
+			if err := addFlowVPCInterfaces(ctx, conn, d); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s) VPC interfaces: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("media_stream") {
+			if err := updateFlowMediaStreams(ctx, conn, d, true); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s) media streams: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("source") {
+			if err := updateFlowSources(ctx, conn, d); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s) sources: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("output") {
+			if err := updateFlowOutputs(ctx, conn, d); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s) outputs: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("entitlement") {
+			if err := updateFlowEntitlements(ctx, conn, d); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s) entitlements: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("media_stream") {
+			if err := updateFlowMediaStreams(ctx, conn, d, false); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s) media streams: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("vpc_interface") {
+			if err := removeFlowVPCInterfaces(ctx, conn, d); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s) VPC interfaces: %s", d.Id(), err)
+			}
+		}
+
+		if _, err := waitFlowStandby(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+		}
+
+		if err := updateFlowState(ctx, conn, d.Id(), mediaconnect.StatusStandby, d.Get("state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+	} else if d.HasChange("state") {
+		o, n := d.GetChange("state")
+		if err := updateFlowState(ctx, conn, d.Id(), o.(string), n.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFlowRead(ctx, d, meta)...)
+}
+
+func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	// A running flow must be stopped before it can be deleted.
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+		if err := updateFlowState(ctx, conn, d.Id(), mediaconnect.StatusActive, mediaconnect.StatusStandby, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting MediaConnect Flow: %s", d.Id())
+	_, err = conn.DeleteFlowWithContext(ctx, &mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateFlowState starts or stops a flow so that it reaches the configured state.
+func updateFlowState(ctx context.Context, conn *mediaconnect.MediaConnect, arn, currentState, configuredState string, timeout time.Duration) error {
+	if currentState == configuredState {
+		return nil
+	}
+
+	switch configuredState {
+	case mediaconnect.StatusActive:
+		log.Printf("[INFO] Starting MediaConnect Flow: %s", arn)
+		if _, err := conn.StartFlowWithContext(ctx, &mediaconnect.StartFlowInput{
+			FlowArn: aws.String(arn),
+		}); err != nil {
+			return err
+		}
+
+		if _, err := waitFlowActive(ctx, conn, arn, timeout); err != nil {
+			return err
+		}
+	case mediaconnect.StatusStandby:
+		log.Printf("[INFO] Stopping MediaConnect Flow: %s", arn)
+		if _, err := conn.StopFlowWithContext(ctx, &mediaconnect.StopFlowInput{
+			FlowArn: aws.String(arn),
+		}); err != nil {
+			return err
+		}
+
+		if _, err := waitFlowStandby(ctx, conn, arn, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flowChanges partitions the old and new values of a list of nested blocks, keyed by name,
+// into blocks that are removed, added and changed.
+func flowChanges(d *schema.ResourceData, key, nameKey string) (removed, added, changed []map[string]interface{}) {
+	o, n := d.GetChange(key)
+	os, ns := make(map[string]map[string]interface{}), make(map[string]map[string]interface{})
+
+	for _, tfMapRaw := range o.([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			os[tfMap[nameKey].(string)] = tfMap
+		}
+	}
+
+	for _, tfMapRaw := range n.([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			ns[tfMap[nameKey].(string)] = tfMap
+		}
+	}
+
+	for name, tfMap := range os {
+		if _, ok := ns[name]; !ok {
+			removed = append(removed, tfMap)
+		}
+	}
+
+	for name, tfMap := range ns {
+		if old, ok := os[name]; !ok {
+			added = append(added, tfMap)
+		} else if !flowBlockEqual(old, tfMap) {
+			// Carry over the computed ARN so that the block can be updated in place.
+			if v, ok := old["arn"]; ok {
+				tfMap["arn"] = v
+			}
+			changed = append(changed, tfMap)
+		}
+	}
+
+	return removed, added, changed
+}
+
+func flowBlockEqual(old, new map[string]interface{}) bool {
+	for k, v := range new {
+		// Computed attributes are unknown in the new value.
+		if k == "arn" || k == "ingest_ip" || k == "fmt" || k == "network_interface_ids" {
+			continue
+		}
+
+		switch v := v.(type) {
+		case *schema.Set:
+			ov, ok := old[k].(*schema.Set)
+			if !ok || !ov.Equal(v) {
+				return false
+			}
+		case []interface{}:
+			ov, ok := old[k].([]interface{})
+			if !ok || len(ov) != len(v) {
+				return false
+			}
+			for i := range v {
+				om, ook := ov[i].(map[string]interface{})
+				nm, nok := v[i].(map[string]interface{})
+				if ook && nok {
+					if !flowBlockEqual(om, nm) {
+						return false
+					}
+				} else if ov[i] != v[i] {
+					return false
+				}
+			}
+		default:
+			if old[k] != v {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func addFlowVPCInterfaces(ctx context.Context, conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	_, added, changed := flowChanges(d, "vpc_interface", "name")
+
+	// VPC interfaces can't be modified in place, so changed interfaces are replaced.
+	for _, tfMap := range changed {
+		if err := removeFlowVPCInterface(ctx, conn, d.Id(), tfMap["name"].(string)); err != nil {
+			return err
+		}
+	}
+
+	added = append(added, changed...)
+	if len(added) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+	for _, tfMap := range added {
+		tfList = append(tfList, tfMap)
+	}
+
+	_, err := conn.AddFlowVpcInterfacesWithContext(ctx, &mediaconnect.AddFlowVpcInterfacesInput{
+		FlowArn:       aws.String(d.Id()),
+		VpcInterfaces: expandVPCInterfaceRequests(tfList),
+	})
+
+	return err
+}
+
+func removeFlowVPCInterfaces(ctx context.Context, conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	removed, _, _ := flowChanges(d, "vpc_interface", "name")
+
+	for _, tfMap := range removed {
+		if err := removeFlowVPCInterface(ctx, conn, d.Id(), tfMap["name"].(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func removeFlowVPCInterface(ctx context.Context, conn *mediaconnect.MediaConnect, arn, name string) error {
+	_, err := conn.RemoveFlowVpcInterfaceWithContext(ctx, &mediaconnect.RemoveFlowVpcInterfaceInput{
+		FlowArn:          aws.String(arn),
+		VpcInterfaceName: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+// updateFlowMediaStreams adds and updates media streams before sources and outputs change,
+// and removes media streams once nothing refers to them any more.
+func updateFlowMediaStreams(ctx context.Context, conn *mediaconnect.MediaConnect, d *schema.ResourceData, addAndUpdate bool) error {
+	removed, added, changed := flowChanges(d, "media_stream", "media_stream_name")
+
+	if !addAndUpdate {
+		for _, tfMap := range removed {
+			_, err := conn.RemoveFlowMediaStreamWithContext(ctx, &mediaconnect.RemoveFlowMediaStreamInput{
+				FlowArn:         aws.String(d.Id()),
+				MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+			})
+
+			if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if len(added) > 0 {
+		var tfList []interface{}
+		for _, tfMap := range added {
+			tfList = append(tfList, tfMap)
+		}
+
+		if _, err := conn.AddFlowMediaStreamsWithContext(ctx, &mediaconnect.AddFlowMediaStreamsInput{
+			FlowArn:      aws.String(d.Id()),
+			MediaStreams: expandAddMediaStreamRequests(tfList),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range changed {
+		apiObject := expandAddMediaStreamRequest(tfMap)
+		input := &mediaconnect.UpdateFlowMediaStreamInput{
+			Attributes:      apiObject.Attributes,
+			ClockRate:       apiObject.ClockRate,
+			Description:     apiObject.Description,
+			FlowArn:         aws.String(d.Id()),
+			MediaStreamName: apiObject.MediaStreamName,
+			MediaStreamType: apiObject.MediaStreamType,
+			VideoFormat:     apiObject.VideoFormat,
+		}
+
+		if _, err := conn.UpdateFlowMediaStreamWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateFlowSources(ctx context.Context, conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	removed, added, changed := flowChanges(d, "source", "name")
+
+	// A flow must always have at least one source, so sources are added before they are removed.
+	if len(added) > 0 {
+		var tfList []interface{}
+		for _, tfMap := range added {
+			tfList = append(tfList, tfMap)
+		}
+
+		if _, err := conn.AddFlowSourcesWithContext(ctx, &mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(d.Id()),
+			Sources: expandSetSourceRequests(tfList),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range changed {
+		apiObject := expandSetSourceRequest(tfMap)
+		input := &mediaconnect.UpdateFlowSourceInput{
+			Decryption:                      expandUpdateEncryption(apiObject.Decryption),
+			Description:                     apiObject.Description,
+			EntitlementArn:                  apiObject.EntitlementArn,
+			FlowArn:                         aws.String(d.Id()),
+			IngestPort:                      apiObject.IngestPort,
+			MaxBitrate:                      apiObject.MaxBitrate,
+			MaxLatency:                      apiObject.MaxLatency,
+			MaxSyncBuffer:                   apiObject.MaxSyncBuffer,
+			MediaStreamSourceConfigurations: apiObject.MediaStreamSourceConfigurations,
+			MinLatency:                      apiObject.MinLatency,
+			Protocol:                        apiObject.Protocol,
+			SenderControlPort:               apiObject.SenderControlPort,
+			SenderIpAddress:                 apiObject.SenderIpAddress,
+			SourceArn:                       aws.String(tfMap["arn"].(string)),
+			SourceListenerAddress:           apiObject.SourceListenerAddress,
+			SourceListenerPort:              apiObject.SourceListenerPort,
+			StreamId:                        apiObject.StreamId,
+			VpcInterfaceName:                apiObject.VpcInterfaceName,
+			WhitelistCidr:                   apiObject.WhitelistCidr,
+		}
+
+		if _, err := conn.UpdateFlowSourceWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range removed {
+		_, err := conn.RemoveFlowSourceWithContext(ctx, &mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(d.Id()),
+			SourceArn: aws.String(tfMap["arn"].(string)),
+		})
+
+		if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateFlowOutputs(ctx context.Context, conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	removed, added, changed := flowChanges(d, "output", "name")
+
+	for _, tfMap := range removed {
+		_, err := conn.RemoveFlowOutputWithContext(ctx, &mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(d.Id()),
+			OutputArn: aws.String(tfMap["arn"].(string)),
+		})
+
+		if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(added) > 0 {
+		var tfList []interface{}
+		for _, tfMap := range added {
+			tfList = append(tfList, tfMap)
+		}
+
+		if _, err := conn.AddFlowOutputsWithContext(ctx, &mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(d.Id()),
+			Outputs: expandAddOutputRequests(tfList),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range changed {
+		apiObject := expandAddOutputRequest(tfMap)
+		input := &mediaconnect.UpdateFlowOutputInput{
+			CidrAllowList:                   apiObject.CidrAllowList,
+			Description:                     apiObject.Description,
+			Destination:                     apiObject.Destination,
+			Encryption:                      expandUpdateEncryption(apiObject.Encryption),
+			FlowArn:                         aws.String(d.Id()),
+			MaxLatency:                      apiObject.MaxLatency,
+			MediaStreamOutputConfigurations: apiObject.MediaStreamOutputConfigurations,
+			MinLatency:                      apiObject.MinLatency,
+			OutputArn:                       aws.String(tfMap["arn"].(string)),
+			Port:                            apiObject.Port,
+			Protocol:                        apiObject.Protocol,
+			RemoteId:                        apiObject.RemoteId,
+			SenderControlPort:               apiObject.SenderControlPort,
+			SmoothingLatency:                apiObject.SmoothingLatency,
+			StreamId:                        apiObject.StreamId,
+			VpcInterfaceAttachment:          apiObject.VpcInterfaceAttachment,
+		}
+
+		if _, err := conn.UpdateFlowOutputWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateFlowEntitlements(ctx context.Context, conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	removed, added, changed := flowChanges(d, "entitlement", "name")
+
+	for _, tfMap := range removed {
+		_, err := conn.RevokeFlowEntitlementWithContext(ctx, &mediaconnect.RevokeFlowEntitlementInput{
+			EntitlementArn: aws.String(tfMap["arn"].(string)),
+			FlowArn:        aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(added) > 0 {
+		var tfList []interface{}
+		for _, tfMap := range added {
+			tfList = append(tfList, tfMap)
+		}
+
+		if _, err := conn.GrantFlowEntitlementsWithContext(ctx, &mediaconnect.GrantFlowEntitlementsInput{
+			Entitlements: expandGrantEntitlementRequests(tfList),
+			FlowArn:      aws.String(d.Id()),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range changed {
+		apiObject := expandGrantEntitlementRequest(tfMap)
+		input := &mediaconnect.UpdateFlowEntitlementInput{
+			Description:       apiObject.Description,
+			Encryption:        expandUpdateEncryption(apiObject.Encryption),
+			EntitlementArn:    aws.String(tfMap["arn"].(string)),
+			EntitlementStatus: apiObject.EntitlementStatus,
+			FlowArn:           aws.String(d.Id()),
+			Subscribers:       apiObject.Subscribers,
+		}
+
+		if _, err := conn.UpdateFlowEntitlementWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func FindFlowByARN(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	input := &mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitFlowStandby(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating, mediaconnect.StatusStopping},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowActive(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStandby, mediaconnect.StatusStarting, mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusActive},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusDeleting, mediaconnect.StatusStandby},
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// sortByName orders flattened blocks to match the order of the configured blocks.
+// The API doesn't preserve the order in which sources, outputs and entitlements were added.
+func sortByName(tfList, configured []interface{}) []interface{} {
+	return sortByKey(tfList, configured, "name")
+}
+
+func sortByMediaStreamName(tfList, configured []interface{}) []interface{} {
+	return sortByKey(tfList, configured, "media_stream_name")
+}
+
+func sortByKey(tfList, configured []interface{}, key string) []interface{} {
+	order := make(map[string]int)
+	for i, tfMapRaw := range configured {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			order[tfMap[key].(string)] = i
+		}
+	}
+
+	index := func(tfMapRaw interface{}) int {
+		if v, ok := order[tfMapRaw.(map[string]interface{})[key].(string)]; ok {
+			return v
+		}
+
+		return len(order)
+	}
+
+	sort.SliceStable(tfList, func(i, j int) bool {
+		return index(tfList[i]) < index(tfList[j])
+	})
+
+	return tfList
+}
+
+func expandEncryption(tfList []interface{}) *mediaconnect.Encryption {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &mediaconnect.Encryption{}
+
+	if v, ok := tfMap["algorithm"].(string); ok && v != "" {
+		apiObject.Algorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_type"].(string); ok && v != "" {
+		apiObject.KeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateEncryption(apiObject *mediaconnect.Encryption) *mediaconnect.UpdateEncryption {
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateEncryption{
+		Algorithm:                    apiObject.Algorithm,
+		ConstantInitializationVector: apiObject.ConstantInitializationVector,
+		DeviceId:                     apiObject.DeviceId,
+		KeyType:                      apiObject.KeyType,
+		Region:                       apiObject.Region,
+		ResourceId:                   apiObject.ResourceId,
+		RoleArn:                      apiObject.RoleArn,
+		SecretArn:                    apiObject.SecretArn,
+		Url:                          apiObject.Url,
+	}
+}
+
+func expandSetSourceRequests(tfList []interface{}) []*mediaconnect.SetSourceRequest {
+	var apiObjects []*mediaconnect.SetSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandSetSourceRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandSetSourceRequest(tfMap map[string]interface{}) *mediaconnect.SetSourceRequest {
+	apiObject := &mediaconnect.SetSourceRequest{}
+
+	if v, ok := tfMap["decryption"].([]interface{}); ok {
+		apiObject.Decryption = expandEncryption(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+		apiObject.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+		apiObject.IngestPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_sync_buffer"].(int); ok && v != 0 {
+		apiObject.MaxSyncBuffer = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["media_stream_source_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MediaStreamSourceConfigurations = expandMediaStreamSourceConfigurationRequests(v)
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["sender_control_port"].(int); ok && v != 0 {
+		apiObject.SenderControlPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["sender_ip_address"].(string); ok && v != "" {
+		apiObject.SenderIpAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_listener_address"].(string); ok && v != "" {
+		apiObject.SourceListenerAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_listener_port"].(int); ok && v != 0 {
+		apiObject.SourceListenerPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+		apiObject.WhitelistCidr = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaStreamSourceConfigurationRequests(tfList []interface{}) []*mediaconnect.MediaStreamSourceConfigurationRequest {
+	var apiObjects []*mediaconnect.MediaStreamSourceConfigurationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.MediaStreamSourceConfigurationRequest{
+			EncodingName:    aws.String(tfMap["encoding_name"].(string)),
+			MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		}
+
+		if v, ok := tfMap["input_configuration"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.InputConfigurations = append(apiObject.InputConfigurations, &mediaconnect.InputConfigurationRequest{
+					InputPort: aws.Int64(int64(tfMap["input_port"].(int))),
+					Interface: &mediaconnect.InterfaceRequest{
+						Name: aws.String(tfMap["interface_name"].(string)),
+					},
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAddOutputRequests(tfList []interface{}) []*mediaconnect.AddOutputRequest {
+	var apiObjects []*mediaconnect.AddOutputRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAddOutputRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandAddOutputRequest(tfMap map[string]interface{}) *mediaconnect.AddOutputRequest {
+	apiObject := &mediaconnect.AddOutputRequest{}
+
+	if v, ok := tfMap["cidr_allow_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CidrAllowList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok {
+		apiObject.Encryption = expandEncryption(v)
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["media_stream_output_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MediaStreamOutputConfigurations = expandMediaStreamOutputConfigurationRequests(v)
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+		apiObject.RemoteId = aws.String(v)
+	}
+
+	if v, ok := tfMap["sender_control_port"].(int); ok && v != 0 {
+		apiObject.SenderControlPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+		apiObject.SmoothingLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceAttachment = &mediaconnect.VpcInterfaceAttachment{
+			VpcInterfaceName: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func expandMediaStreamOutputConfigurationRequests(tfList []interface{}) []*mediaconnect.MediaStreamOutputConfigurationRequest {
+	var apiObjects []*mediaconnect.MediaStreamOutputConfigurationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.MediaStreamOutputConfigurationRequest{
+			EncodingName:    aws.String(tfMap["encoding_name"].(string)),
+			MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		}
+
+		if v, ok := tfMap["destination_configuration"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.DestinationConfigurations = append(apiObject.DestinationConfigurations, &mediaconnect.DestinationConfigurationRequest{
+					DestinationIp:   aws.String(tfMap["destination_ip"].(string)),
+					DestinationPort: aws.Int64(int64(tfMap["destination_port"].(int))),
+					Interface: &mediaconnect.InterfaceRequest{
+						Name: aws.String(tfMap["interface_name"].(string)),
+					},
+				})
+			}
+		}
+
+		if v, ok := tfMap["encoding_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.EncodingParameters = &mediaconnect.EncodingParametersRequest{
+				CompressionFactor: aws.Float64(tfMap["compression_factor"].(float64)),
+				EncoderProfile:    aws.String(tfMap["encoder_profile"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGrantEntitlementRequests(tfList []interface{}) []*mediaconnect.GrantEntitlementRequest {
+	var apiObjects []*mediaconnect.GrantEntitlementRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandGrantEntitlementRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandGrantEntitlementRequest(tfMap map[string]interface{}) *mediaconnect.GrantEntitlementRequest {
+	apiObject := &mediaconnect.GrantEntitlementRequest{}
+
+	if v, ok := tfMap["data_transfer_subscriber_fee_percent"].(int); ok && v != 0 {
+		apiObject.DataTransferSubscriberFeePercent = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok {
+		apiObject.Encryption = expandEncryption(v)
+	}
+
+	if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+		apiObject.EntitlementStatus = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["subscribers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Subscribers = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandVPCInterfaceRequests(tfList []interface{}) []*mediaconnect.VpcInterfaceRequest {
+	var apiObjects []*mediaconnect.VpcInterfaceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.VpcInterfaceRequest{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["network_interface_type"].(string); ok && v != "" {
+			apiObject.NetworkInterfaceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["subnet_id"].(string); ok && v != "" {
+			apiObject.SubnetId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAddMediaStreamRequests(tfList []interface{}) []*mediaconnect.AddMediaStreamRequest {
+	var apiObjects []*mediaconnect.AddMediaStreamRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAddMediaStreamRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandAddMediaStreamRequest(tfMap map[string]interface{}) *mediaconnect.AddMediaStreamRequest {
+	apiObject := &mediaconnect.AddMediaStreamRequest{}
+
+	if v, ok := tfMap["attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Attributes = &mediaconnect.MediaStreamAttributesRequest{}
+
+		if v, ok := tfMap["fmtp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			fmtp := &mediaconnect.FmtpRequest{}
+
+			if v, ok := tfMap["channel_order"].(string); ok && v != "" {
+				fmtp.ChannelOrder = aws.String(v)
+			}
+
+			if v, ok := tfMap["colorimetry"].(string); ok && v != "" {
+				fmtp.Colorimetry = aws.String(v)
+			}
+
+			if v, ok := tfMap["exact_framerate"].(string); ok && v != "" {
+				fmtp.ExactFramerate = aws.String(v)
+			}
+
+			if v, ok := tfMap["par"].(string); ok && v != "" {
+				fmtp.Par = aws.String(v)
+			}
+
+			if v, ok := tfMap["range"].(string); ok && v != "" {
+				fmtp.Range = aws.String(v)
+			}
+
+			if v, ok := tfMap["scan_mode"].(string); ok && v != "" {
+				fmtp.ScanMode = aws.String(v)
+			}
+
+			if v, ok := tfMap["tcs"].(string); ok && v != "" {
+				fmtp.Tcs = aws.String(v)
+			}
+
+			apiObject.Attributes.Fmtp = fmtp
+		}
+
+		if v, ok := tfMap["lang"].(string); ok && v != "" {
+			apiObject.Attributes.Lang = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["clock_rate"].(int); ok && v != 0 {
+		apiObject.ClockRate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["media_stream_id"].(int); ok {
+		apiObject.MediaStreamId = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["media_stream_name"].(string); ok && v != "" {
+		apiObject.MediaStreamName = aws.String(v)
+	}
+
+	if v, ok := tfMap["media_stream_type"].(string); ok && v != "" {
+		apiObject.MediaStreamType = aws.String(v)
+	}
+
+	if v, ok := tfMap["video_format"].(string); ok && v != "" {
+		apiObject.VideoFormat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFailoverConfig(tfMap map[string]interface{}) *mediaconnect.FailoverConfig {
+	apiObject := &mediaconnect.FailoverConfig{}
+
+	if v, ok := tfMap["failover_mode"].(string); ok && v != "" {
+		apiObject.FailoverMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["primary_source"].(string); ok && v != "" {
+		apiObject.SourcePriority = &mediaconnect.SourcePriority{
+			PrimarySource: aws.String(v),
+		}
+	}
+
+	if v, ok := tfMap["recovery_window"].(int); ok && v != 0 {
+		apiObject.RecoveryWindow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEncryption(apiObject *mediaconnect.Encryption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"algorithm":                      aws.StringValue(apiObject.Algorithm),
+		"constant_initialization_vector": aws.StringValue(apiObject.ConstantInitializationVector),
+		"device_id":                      aws.StringValue(apiObject.DeviceId),
+		"key_type":                       aws.StringValue(apiObject.KeyType),
+		"region":                         aws.StringValue(apiObject.Region),
+		"resource_id":                    aws.StringValue(apiObject.ResourceId),
+		"role_arn":                       aws.StringValue(apiObject.RoleArn),
+		"secret_arn":                     aws.StringValue(apiObject.SecretArn),
+		"url":                            aws.StringValue(apiObject.Url),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSources(apiObjects []*mediaconnect.Source) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":                               aws.StringValue(apiObject.SourceArn),
+			"decryption":                        flattenEncryption(apiObject.Decryption),
+			"description":                       aws.StringValue(apiObject.Description),
+			"entitlement_arn":                   aws.StringValue(apiObject.EntitlementArn),
+			"ingest_ip":                         aws.StringValue(apiObject.IngestIp),
+			"ingest_port":                       aws.Int64Value(apiObject.IngestPort),
+			"media_stream_source_configuration": flattenMediaStreamSourceConfigurations(apiObject.MediaStreamSourceConfigurations),
+			"name":                              aws.StringValue(apiObject.Name),
+			"sender_control_port":               aws.Int64Value(apiObject.SenderControlPort),
+			"sender_ip_address":                 aws.StringValue(apiObject.SenderIpAddress),
+			"vpc_interface_name":                aws.StringValue(apiObject.VpcInterfaceName),
+			"whitelist_cidr":                    aws.StringValue(apiObject.WhitelistCidr),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["max_bitrate"] = aws.Int64Value(v.MaxBitrate)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["max_sync_buffer"] = aws.Int64Value(v.MaxSyncBuffer)
+			tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["source_listener_address"] = aws.StringValue(v.SourceListenerAddress)
+			tfMap["source_listener_port"] = aws.Int64Value(v.SourceListenerPort)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMediaStreamSourceConfigurations(apiObjects []*mediaconnect.MediaStreamSourceConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var inputConfigurations []interface{}
+		for _, v := range apiObject.InputConfigurations {
+			if v == nil || v.Interface == nil {
+				continue
+			}
+
+			inputConfigurations = append(inputConfigurations, map[string]interface{}{
+				"input_port":     aws.Int64Value(v.InputPort),
+				"interface_name": aws.StringValue(v.Interface.Name),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"encoding_name":       aws.StringValue(apiObject.EncodingName),
+			"input_configuration": inputConfigurations,
+			"media_stream_name":   aws.StringValue(apiObject.MediaStreamName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputs(apiObjects []*mediaconnect.Output) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":                               aws.StringValue(apiObject.OutputArn),
+			"description":                       aws.StringValue(apiObject.Description),
+			"destination":                       aws.StringValue(apiObject.Destination),
+			"encryption":                        flattenEncryption(apiObject.Encryption),
+			"media_stream_output_configuration": flattenMediaStreamOutputConfigurations(apiObject.MediaStreamOutputConfigurations),
+			"name":                              aws.StringValue(apiObject.Name),
+			"port":                              aws.Int64Value(apiObject.Port),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["cidr_allow_list"] = aws.StringValueSlice(v.CidrAllowList)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["remote_id"] = aws.StringValue(v.RemoteId)
+			tfMap["sender_control_port"] = aws.Int64Value(v.SenderControlPort)
+			tfMap["smoothing_latency"] = aws.Int64Value(v.SmoothingLatency)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		if v := apiObject.VpcInterfaceAttachment; v != nil {
+			tfMap["vpc_interface_name"] = aws.StringValue(v.VpcInterfaceName)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMediaStreamOutputConfigurations(apiObjects []*mediaconnect.MediaStreamOutputConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"encoding_name":     aws.StringValue(apiObject.EncodingName),
+			"media_stream_name": aws.StringValue(apiObject.MediaStreamName),
+		}
+
+		var destinationConfigurations []interface{}
+		for _, v := range apiObject.DestinationConfigurations {
+			if v == nil || v.Interface == nil {
+				continue
+			}
+
+			destinationConfigurations = append(destinationConfigurations, map[string]interface{}{
+				"destination_ip":   aws.StringValue(v.DestinationIp),
+				"destination_port": aws.Int64Value(v.DestinationPort),
+				"interface_name":   aws.StringValue(v.Interface.Name),
+			})
+		}
+		tfMap["destination_configuration"] = destinationConfigurations
+
+		if v := apiObject.EncodingParameters; v != nil {
+			tfMap["encoding_parameters"] = []interface{}{map[string]interface{}{
+				"compression_factor": aws.Float64Value(v.CompressionFactor),
+				"encoder_profile":    aws.StringValue(v.EncoderProfile),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEntitlements(apiObjects []*mediaconnect.Entitlement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                                  aws.StringValue(apiObject.EntitlementArn),
+			"data_transfer_subscriber_fee_percent": aws.Int64Value(apiObject.DataTransferSubscriberFeePercent),
+			"description":                          aws.StringValue(apiObject.Description),
+			"encryption":                           flattenEncryption(apiObject.Encryption),
+			"entitlement_status":                   aws.StringValue(apiObject.EntitlementStatus),
+			"name":                                 aws.StringValue(apiObject.Name),
+			"subscribers":                          aws.StringValueSlice(apiObject.Subscribers),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVPCInterfaces(apiObjects []*mediaconnect.VpcInterface) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                   aws.StringValue(apiObject.Name),
+			"network_interface_ids":  aws.StringValueSlice(apiObject.NetworkInterfaceIds),
+			"network_interface_type": aws.StringValue(apiObject.NetworkInterfaceType),
+			"role_arn":               aws.StringValue(apiObject.RoleArn),
+			"security_group_ids":     aws.StringValueSlice(apiObject.SecurityGroupIds),
+			"subnet_id":              aws.StringValue(apiObject.SubnetId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaStreams(apiObjects []*mediaconnect.MediaStream) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"clock_rate":        aws.Int64Value(apiObject.ClockRate),
+			"description":       aws.StringValue(apiObject.Description),
+			"fmt":               aws.Int64Value(apiObject.Fmt),
+			"media_stream_id":   aws.Int64Value(apiObject.MediaStreamId),
+			"media_stream_name": aws.StringValue(apiObject.MediaStreamName),
+			"media_stream_type": aws.StringValue(apiObject.MediaStreamType),
+			"video_format":      aws.StringValue(apiObject.VideoFormat),
+		}
+
+		if v := apiObject.Attributes; v != nil {
+			attributes := map[string]interface{}{
+				"lang": aws.StringValue(v.Lang),
+			}
+
+			if v := v.Fmtp; v != nil {
+				attributes["fmtp"] = []interface{}{map[string]interface{}{
+					"channel_order":   aws.StringValue(v.ChannelOrder),
+					"colorimetry":     aws.StringValue(v.Colorimetry),
+					"exact_framerate": aws.StringValue(v.ExactFramerate),
+					"par":             aws.StringValue(v.Par),
+					"range":           aws.StringValue(v.Range),
+					"scan_mode":       aws.StringValue(v.ScanMode),
+					"tcs":             aws.StringValue(v.Tcs),
+				}}
+			}
+
+			tfMap["attributes"] = []interface{}{attributes}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMaintenance(apiObject *mediaconnect.Maintenance) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"maintenance_day":        aws.StringValue(apiObject.MaintenanceDay),
+		"maintenance_start_hour": aws.StringValue(apiObject.MaintenanceStartHour),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFailoverConfig(apiObject *mediaconnect.FailoverConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failover_mode":   aws.StringValue(apiObject.FailoverMode),
+		"recovery_window": aws.Int64Value(apiObject.RecoveryWindow),
+		"state":           aws.StringValue(apiObject.State),
+	}
+
+	if v := apiObject.SourcePriority; v != nil {
+		tfMap["primary_source"] = aws.StringValue(v.PrimarySource)
+	}
+
+	return []interface{}{tfMap}
+}
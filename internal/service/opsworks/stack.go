@@ -33,6 +33,7 @@ const (
 
 // @SDKResource("aws_opsworks_stack", name="Stack")
 // @Tags
+// @Region(overrideEnabled=false)
 func ResourceStack() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStackCreate,
@@ -48,6 +48,7 @@ var ServiceAccountPerRegionMap = map[string]string{
 }
 
 // @SDKDataSource("aws_redshift_service_account")
+// @Region(overrideEnabled=false)
 func DataSourceServiceAccount() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceServiceAccountRead,
@@ -44,6 +44,7 @@ const (
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
 // @Tags
+// @Region(overrideEnabled=false)
 func ResourceBucket() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketCreate,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_s3_bucket")
+// @Region(overrideEnabled=false)
 func DataSourceBucket() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceBucketRead,
@@ -237,3 +237,95 @@ func newDeleteObjectVersionError(err *s3.Error) error {
 
 	return fmt.Errorf("deleting: %w", newObjectVersionError(aws.StringValue(err.Key), aws.StringValue(err.VersionId), awsErr))
 }
+
+// emptyDirectoryBucket empties the specified S3 Express One Zone directory bucket by deleting all objects.
+// Directory buckets don't support versioning or S3 Object Lock.
+// Returns the number of objects deleted.
+func emptyDirectoryBucket(ctx context.Context, conn *s3.S3, bucket string) (int64, error) {
+	var nObjects int64
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	var lastErr error
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		n, err := deletePageOfObjects(ctx, conn, bucket, page)
+		nObjects += n
+
+		if err != nil {
+			lastErr = err
+
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		err = nil
+	}
+
+	if err != nil {
+		return nObjects, fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	if lastErr != nil {
+		return nObjects, lastErr
+	}
+
+	return nObjects, nil
+}
+
+// deletePageOfObjects deletes a page (<= 1000) of S3 objects.
+// Returns the number of objects deleted.
+func deletePageOfObjects(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectsV2Output) (int64, error) {
+	var nObjects int64
+
+	toDelete := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
+	for _, v := range page.Contents {
+		toDelete = append(toDelete, &s3.ObjectIdentifier{
+			Key: v.Key,
+		})
+	}
+
+	if nObjects = int64(len(toDelete)); nObjects == 0 {
+		return nObjects, nil
+	}
+
+	input := &s3.DeleteObjectsInput{
+		Bucket: aws.String(bucket),
+		Delete: &s3.Delete{
+			Objects: toDelete,
+			Quiet:   aws.Bool(true), // Only report errors.
+		},
+	}
+
+	output, err := conn.DeleteObjectsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nObjects, nil
+	}
+
+	if err != nil {
+		return nObjects, fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	nObjects -= int64(len(output.Errors))
+
+	var deleteErrs *multierror.Error
+
+	for _, v := range output.Errors {
+		deleteErrs = multierror.Append(deleteErrs, newDeleteObjectVersionError(v))
+	}
+
+	if err := deleteErrs.ErrorOrNil(); err != nil {
+		return nObjects, fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	return nObjects, nil
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_s3_directory_bucket", name="Directory Bucket")
+func ResourceDirectoryBucket() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectoryBucketCreate,
+		ReadWithoutTimeout:   resourceDirectoryBucketRead,
+		UpdateWithoutTimeout: resourceDirectoryBucketUpdate,
+		DeleteWithoutTimeout: resourceDirectoryBucketDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDirectoryBucketImport,
+		},
+
+		CustomizeDiff: resourceDirectoryBucketCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validDirectoryBucketName,
+			},
+			"data_redundancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.DataRedundancySingleAvailabilityZone,
+				ValidateFunc: validation.StringInSlice(s3.DataRedundancy_Values(), false),
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      s3.LocationTypeAvailabilityZone,
+							ValidateFunc: validation.StringInSlice(s3.LocationType_Values(), false),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.BucketTypeDirectory,
+				ValidateFunc: validation.StringInSlice(s3.BucketType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectoryBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ExpressControlConn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			Bucket: &s3.BucketInfo{
+				DataRedundancy: aws.String(d.Get("data_redundancy").(string)),
+				Type:           aws.String(d.Get("type").(string)),
+			},
+			Location: expandDirectoryBucketLocationInfo(d.Get("location").([]interface{})),
+		},
+	}
+
+	_, err := conn.CreateBucketWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Directory Bucket (%s): %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, directoryBucketPropagationTimeout, func() (interface{}, error) {
+		return FindDirectoryBucket(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return diag.Errorf("waiting for S3 Directory Bucket (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDirectoryBucketRead(ctx, d, meta)
+}
+
+func resourceDirectoryBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ExpressControlConn(ctx)
+
+	output, err := FindDirectoryBucket(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Bucket (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Directory Bucket (%s): %s", d.Id(), err)
+	}
+
+	bucket := aws.StringValue(output.Name)
+	azID, _ := conns.S3ExpressAvailabilityZoneID(bucket)
+	d.Set("arn", directoryBucketARN(meta.(*conns.AWSClient), aws.StringValue(conn.Config.Region), bucket))
+	d.Set("bucket", bucket)
+	// Directory buckets only support a single Availability Zone location.
+	// The API doesn't return the bucket's configuration, so derive it from the bucket name.
+	d.Set("data_redundancy", s3.DataRedundancySingleAvailabilityZone)
+	if err := d.Set("location", []interface{}{map[string]interface{}{
+		"name": azID,
+		"type": s3.LocationTypeAvailabilityZone,
+	}}); err != nil {
+		return diag.Errorf("setting location: %s", err)
+	}
+	d.Set("type", s3.BucketTypeDirectory)
+
+	return nil
+}
+
+func resourceDirectoryBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only force_destroy can be updated.
+	return resourceDirectoryBucketRead(ctx, d, meta)
+}
+
+func resourceDirectoryBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ExpressControlConn(ctx)
+
+	log.Printf("[INFO] Deleting S3 Directory Bucket: %s", d.Id())
+	_, err := conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeBucketNotEmpty) && d.Get("force_destroy").(bool) {
+		conn, err := meta.(*conns.AWSClient).S3ExpressConn(ctx, d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if n, err := emptyDirectoryBucket(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("emptying S3 Directory Bucket (%s): %s", d.Id(), err)
+		} else {
+			log.Printf("[DEBUG] Deleted %d S3 objects", n)
+		}
+
+		return resourceDirectoryBucketDelete(ctx, d, meta)
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Directory Bucket (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, directoryBucketPropagationTimeout, func() (interface{}, error) {
+		return FindDirectoryBucket(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return diag.Errorf("waiting for S3 Directory Bucket (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDirectoryBucketImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("force_destroy", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDirectoryBucketCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+
+	if bucket == "" {
+		return nil
+	}
+
+	if v, ok := d.GetOk("location.0.name"); ok {
+		if azID, err := conns.S3ExpressAvailabilityZoneID(bucket); err == nil && v.(string) != azID {
+			return fmt.Errorf("bucket name %q must contain the location Availability Zone ID (%s)", bucket, v.(string))
+		}
+	}
+
+	return nil
+}
+
+const (
+	directoryBucketPropagationTimeout = 1 * time.Minute
+)
+
+func FindDirectoryBucket(ctx context.Context, conn *s3.S3, bucket string) (*s3.Bucket, error) {
+	input := &s3.ListDirectoryBucketsInput{}
+	var output *s3.Bucket
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, input, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Buckets {
+			if v != nil && aws.StringValue(v.Name) == bucket {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func expandDirectoryBucketLocationInfo(tfList []interface{}) *s3.LocationInfo {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &s3.LocationInfo{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func directoryBucketARN(client *conns.AWSClient, region, bucket string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "s3express",
+		Region:    region,
+		AccountID: client.AccountID,
+		Resource:  "bucket/" + bucket,
+	}.String()
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_s3_directory_buckets", name="Directory Buckets")
+func DataSourceDirectoryBuckets() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDirectoryBucketsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"buckets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDirectoryBucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient)
+	conn := client.S3ExpressControlConn(ctx)
+	region := aws.StringValue(conn.Config.Region)
+
+	var arns, buckets []string
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, &s3.ListDirectoryBucketsInput{}, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Buckets {
+			if v == nil {
+				continue
+			}
+
+			bucket := aws.StringValue(v.Name)
+			arns = append(arns, directoryBucketARN(client, region, bucket))
+			buckets = append(buckets, bucket)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("listing S3 Directory Buckets: %s", err)
+	}
+
+	d.SetId(region)
+	d.Set("arns", arns)
+	d.Set("buckets", buckets)
+
+	return nil
+}
@@ -200,10 +200,15 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	conn, err := objectConn(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, objectCreationTimeout, func() (interface{}, error) {
 		return FindObjectByThreePartKey(ctx, conn, bucket, key, "")
 	}, d.IsNewResource())
@@ -259,6 +264,11 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 		d.Set("storage_class", output.StorageClass)
 	}
 
+	// Objects in directory buckets don't support tags.
+	if isDirectoryBucket(bucket) {
+		return diags
+	}
+
 	// Retry due to S3 eventual consistency
 	tagsRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
 		return ObjectListTags(ctx, conn, bucket, key)
@@ -285,10 +295,13 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return append(diags, resourceObjectUpload(ctx, d, meta)...)
 	}
 
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
-
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	conn, err := objectConn(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 
 	if d.HasChange("acl") {
 		_, err := conn.PutObjectAclWithContext(ctx, &s3.PutObjectAclInput{
@@ -340,7 +353,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags_all") {
+	if d.HasChange("tags_all") && !isDirectoryBucket(bucket) {
 		o, n := d.GetChange("tags_all")
 
 		if err := ObjectUpdateTags(ctx, conn, bucket, key, o, n); err != nil {
@@ -353,17 +366,22 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	conn, err := objectConn(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	// We are effectively ignoring all leading '/'s in the key name and
 	// treating multiple '/'s as a single '/' as aws.Config.DisableRestProtocolURICleaning is false
 	key = strings.TrimLeft(key, "/")
 	key = regexp.MustCompile(`/+`).ReplaceAllString(key, "/")
 
-	var err error
-	if _, ok := d.GetOk("version_id"); ok {
+	// Directory buckets don't support versioning.
+	if _, ok := d.GetOk("version_id"); ok && !isDirectoryBucket(bucket) {
 		_, err = DeleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), false)
 	} else {
 		err = deleteObjectVersion(ctx, conn, bucket, key, "", false)
@@ -397,7 +415,13 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+	bucket := d.Get("bucket").(string)
+	conn, err := objectConn(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	uploader := s3manager.NewUploaderWithClient(conn)
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
@@ -438,7 +462,6 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		body = bytes.NewReader([]byte{})
 	}
 
-	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
 	input := &s3manager.UploadInput{
@@ -492,7 +515,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if len(tags) > 0 {
+	if len(tags) > 0 && !isDirectoryBucket(bucket) {
 		// The tag-set must be encoded as URL Query parameters.
 		input.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
 	}
@@ -554,6 +577,16 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if bucket := d.Get("bucket").(string); isDirectoryBucket(bucket) {
+		if v, ok := d.GetOk("acl"); ok && v.(string) != "" {
+			return fmt.Errorf("acl is not supported for objects in S3 Directory Bucket (%s)", bucket)
+		}
+
+		if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+			return fmt.Errorf("tags are not supported for objects in S3 Directory Bucket (%s)", bucket)
+		}
+	}
+
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -592,6 +625,16 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
+// objectConn returns the S3 API client used for object operations in the specified bucket.
+// Objects in directory buckets are accessed via the bucket's zonal endpoint.
+func objectConn(ctx context.Context, meta interface{}, bucket string) (*s3.S3, error) {
+	if isDirectoryBucket(bucket) {
+		return meta.(*conns.AWSClient).S3ExpressConn(ctx, bucket)
+	}
+
+	return meta.(*conns.AWSClient).S3Conn(ctx), nil
+}
+
 // DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -85,6 +86,67 @@ func TestAccS3Object_empty(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_directoryBucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckDirectoryBucket(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_directoryBucket(rName, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "EXPRESS_ONEZONE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_directoryBucket(rName, "updated_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "updated_bucket_content"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "force_destroy"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+
+					return fmt.Sprintf("s3://%s/%s", rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"]), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3Object_directoryBucketACL(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckDirectoryBucket(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_directoryBucketACL(rName),
+				ExpectError: regexp.MustCompile(`acl is not supported for objects in S3 Directory Bucket`),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_source(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1377,14 +1439,18 @@ func testAccCheckObjectVersionIdEquals(first, second *s3.GetObjectOutput) resour
 
 func testAccCheckObjectDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
-
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_s3_object" {
 				continue
 			}
 
-			_, err := tfs3.FindObjectByThreePartKey(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"], rs.Primary.Attributes["etag"])
+			conn, err := testAccObjectConn(ctx, rs.Primary.Attributes["bucket"])
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3.FindObjectByThreePartKey(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"], rs.Primary.Attributes["etag"])
 
 			if tfresource.NotFound(err) {
 				continue
@@ -1412,7 +1478,11 @@ func testAccCheckObjectExists(ctx context.Context, n string, obj *s3.GetObjectOu
 			return fmt.Errorf("No S3 Object ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+		conn, err := testAccObjectConn(ctx, rs.Primary.Attributes["bucket"])
+
+		if err != nil {
+			return err
+		}
 
 		input := &s3.GetObjectInput{
 			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
@@ -1422,7 +1492,7 @@ func testAccCheckObjectExists(ctx context.Context, n string, obj *s3.GetObjectOu
 
 		var out *s3.GetObjectOutput
 
-		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 			var err error
 			out, err = conn.GetObjectWithContext(ctx, input)
 
@@ -1452,6 +1522,15 @@ func testAccCheckObjectExists(ctx context.Context, n string, obj *s3.GetObjectOu
 	}
 }
 
+// testAccObjectConn returns the S3 API client used to access objects in the specified bucket.
+func testAccObjectConn(ctx context.Context, bucket string) (*s3.S3, error) {
+	if strings.HasSuffix(bucket, "--x-s3") {
+		return acctest.Provider.Meta().(*conns.AWSClient).S3ExpressConn(ctx, bucket)
+	}
+
+	return acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx), nil
+}
+
 func testAccCheckObjectBody(obj *s3.GetObjectOutput, want string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		body, err := io.ReadAll(obj.Body)
@@ -1601,6 +1680,27 @@ resource "aws_s3_object" "object" {
 `, bucket, key)
 }
 
+func testAccObjectConfig_directoryBucket(rName, content string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_directory_bucket.test.bucket
+  key     = "test-key"
+  content = %[1]q
+}
+`, content))
+}
+
+func testAccObjectConfig_directoryBucketACL(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_basic(rName), `
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_directory_bucket.test.bucket
+  key     = "test-key"
+  content = "some_bucket_content"
+  acl     = "public-read"
+}
+`)
+}
+
 func testAccObjectConfig_empty(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
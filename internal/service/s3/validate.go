@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // ValidBucketName validates any S3 bucket name that is not inside the us-east-1 region.
@@ -56,3 +57,25 @@ func validBucketLifecycleTimestamp(v interface{}, k string) (ws []string, errors
 
 	return
 }
+
+// validDirectoryBucketName validates an S3 Express One Zone directory bucket name.
+// Directory bucket names have the form bucket-base-name--azid--x-s3.
+func validDirectoryBucketName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 3 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf("%q must contain from 3 to 63 characters", k))
+	}
+	if !strings.HasSuffix(value, conns.S3ExpressBucketNameSuffix) {
+		errors = append(errors, fmt.Errorf("%q must end with %q", k, conns.S3ExpressBucketNameSuffix))
+	} else if _, err := conns.S3ExpressAvailabilityZoneID(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be of the form bucket-base-name--azid--x-s3 and contain only lowercase alphanumeric characters and hyphens: %q", k, value))
+	}
+
+	return
+}
+
+// isDirectoryBucket returns whether the specified bucket name is that of an S3 Express One Zone directory bucket.
+func isDirectoryBucket(bucket string) bool {
+	return strings.HasSuffix(bucket, conns.S3ExpressBucketNameSuffix)
+}
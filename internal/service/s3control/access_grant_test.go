@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAccessGrant_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grant_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grant_id"),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_grants_location_id", "aws_s3control_access_grants_location.test", "access_grants_location_id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "grant_scope"),
+					resource.TestCheckResourceAttr(resourceName, "grantee.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee.0.grantee_identifier", "aws_iam_user.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "grantee.0.grantee_type", "IAM"),
+					resource.TestCheckResourceAttr(resourceName, "permission", "READ"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_prefix_type"},
+			},
+		},
+	})
+}
+
+func testAccAccessGrant_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3control.ResourceAccessGrant(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrant_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_prefix_type"},
+			},
+			{
+				Config: testAccAccessGrantConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAccessGrantConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrant_locationConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig_locationConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.0.s3_sub_prefix", "prefixB*"),
+					resource.TestCheckResourceAttr(resourceName, "permission", "READWRITE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_prefix_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_access_grant" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3control.FindAccessGrantByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Access Grant %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessGrantExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn(ctx)
+
+		_, err = tfs3control.FindAccessGrantByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccAccessGrantConfig_baseCustomLocation(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantsLocationConfig_baseIAMRole(rName, "test"), fmt.Sprintf(`
+resource "aws_s3control_access_grants_instance" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_access_grants_location" "test" {
+  depends_on = [aws_s3control_access_grants_instance.test]
+
+  iam_role_arn   = aws_iam_role.test.arn
+  location_scope = "s3://${aws_s3_bucket.test.bucket}/prefixA*"
+}
+
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccAccessGrantConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_baseCustomLocation(rName), `
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READ"
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = aws_iam_user.test.arn
+  }
+}
+`)
+}
+
+func testAccAccessGrantConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_baseCustomLocation(rName), fmt.Sprintf(`
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READ"
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = aws_iam_user.test.arn
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAccessGrantConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_baseCustomLocation(rName), fmt.Sprintf(`
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READ"
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = aws_iam_user.test.arn
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAccessGrantConfig_locationConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_baseCustomLocation(rName), `
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READWRITE"
+
+  access_grants_location_configuration {
+    s3_sub_prefix = "prefixB*"
+  }
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = aws_iam_user.test.arn
+  }
+}
+`)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !generate
+// +build !generate
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Custom S3 Control tag service update functions using the same format as generated code.
+// Access Grants resources are tagged by ARN and use the s3control.Tag type rather than s3control.S3Tag.
+
+// accessGrantsTags returns s3control service tags.
+func accessGrantsTags(tags tftags.KeyValueTags) []*s3control.Tag {
+	result := make([]*s3control.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &s3control.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// keyValueTagsFromAccessGrantsTags creates tftags.KeyValueTags from s3control service tags.
+func keyValueTagsFromAccessGrantsTags(ctx context.Context, tags []*s3control.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(ctx, m)
+}
+
+// getAccessGrantsTagsIn returns s3control service tags from Context.
+// nil is returned if there are no input tags.
+func getAccessGrantsTagsIn(ctx context.Context) []*s3control.Tag {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		if tags := accessGrantsTags(inContext.TagsIn.UnwrapOrDefault()); len(tags) > 0 {
+			return tags
+		}
+	}
+
+	return nil
+}
+
+// setAccessGrantsTagsOut sets s3control service tags in Context.
+func setAccessGrantsTagsOut(ctx context.Context, tags tftags.KeyValueTags) {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = types.Some(tags)
+	}
+}
+
+// accessGrantsListTags lists S3 Control Access Grants resource tags.
+// The identifier is the resource ARN.
+func accessGrantsListTags(ctx context.Context, conn *s3control.S3Control, accountID, identifier string) (tftags.KeyValueTags, error) {
+	input := &s3control.ListTagsForResourceInput{
+		AccountId:   aws.String(accountID),
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResourceWithContext(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsFromAccessGrantsTags(ctx, output.Tags), nil
+}
+
+// accessGrantsUpdateTags updates S3 Control Access Grants resource tags.
+// The identifier is the resource ARN.
+func accessGrantsUpdateTags(ctx context.Context, conn *s3control.S3Control, accountID, identifier string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.S3Control)
+	if len(removedTags) > 0 {
+		input := &s3control.UntagResourceInput{
+			AccountId:   aws.String(accountID),
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.S3Control)
+	if len(updatedTags) > 0 {
+		input := &s3control.TagResourceInput{
+			AccountId:   aws.String(accountID),
+			ResourceArn: aws.String(identifier),
+			Tags:        accessGrantsTags(updatedTags),
+		}
+
+		_, err := conn.TagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
@@ -340,6 +340,7 @@ var prebuiltECRImageIDByRegion_modelMonitor = map[string]string{
 }
 
 // @SDKDataSource("aws_sagemaker_prebuilt_ecr_image")
+// @Region(overrideEnabled=false)
 func DataSourcePrebuiltECRImage() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePrebuiltECRImageRead,
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Secret Version")
+func newEphemeralSecretVersion(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSecretVersion{}, nil
+}
+
+type ephemeralSecretVersion struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSecretVersion) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_secretsmanager_secret_version"
+}
+
+func (e *ephemeralSecretVersion) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"created_date": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret_binary": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secret_id": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"version_stage": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("version_id")),
+				},
+			},
+			"version_stages": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralSecretVersion) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralSecretVersionData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SecretsManagerConn(ctx)
+
+	secretID := data.SecretID.ValueString()
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	}
+	var version string
+
+	if !data.VersionID.IsNull() {
+		version = data.VersionID.ValueString()
+		input.VersionId = aws.String(version)
+	} else {
+		version = "AWSCURRENT"
+		if !data.VersionStage.IsNull() {
+			version = data.VersionStage.ValueString()
+		}
+		input.VersionStage = aws.String(version)
+	}
+
+	output, err := conn.GetSecretValueWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
+		response.Diagnostics.AddError(fmt.Sprintf("Secrets Manager Secret (%s) Version (%s) not found", secretID, version), err.Error())
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Secrets Manager Secret (%s) Version (%s)", secretID, version), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.ARN)
+	if v := output.CreatedDate; v != nil {
+		data.CreatedDate = types.StringValue(aws.TimeValue(v).Format(time.RFC3339))
+	}
+	data.SecretBinary = types.StringValue(string(output.SecretBinary))
+	data.SecretString = flex.StringToFramework(ctx, output.SecretString)
+	if data.VersionID.IsNull() {
+		data.VersionStage = types.StringValue(version)
+	}
+	data.VersionID = flex.StringToFramework(ctx, output.VersionId)
+	data.VersionStages = flex.FlattenFrameworkStringList(ctx, output.VersionStages)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralSecretVersionData struct {
+	ARN           types.String `tfsdk:"arn"`
+	CreatedDate   types.String `tfsdk:"created_date"`
+	SecretBinary  types.String `tfsdk:"secret_binary"`
+	SecretID      types.String `tfsdk:"secret_id"`
+	SecretString  types.String `tfsdk:"secret_string"`
+	VersionID     types.String `tfsdk:"version_id"`
+	VersionStage  types.String `tfsdk:"version_stage"`
+	VersionStages types.List   `tfsdk:"version_stages"`
+}
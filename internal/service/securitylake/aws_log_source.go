@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
+)
+
+// @FrameworkResource(name="AWS Log Source")
+func newResourceAWSLogSource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAWSLogSource{}, nil
+}
+
+type resourceAWSLogSource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceAWSLogSource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_aws_log_source"
+}
+
+func (r *resourceAWSLogSource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"accounts": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+						"regions": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+						"source_name": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.AwsLogSourceName](),
+							},
+						},
+						"source_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAWSLogSource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	apiObject := expandAWSLogSourceConfiguration(ctx, data.Source)
+	input := &securitylake.CreateAwsLogSourceInput{
+		Sources: []awstypes.AwsLogSourceConfiguration{apiObject},
+	}
+
+	output, err := conn.CreateAwsLogSource(ctx, input)
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake AWS Log Source (%s)", apiObject.SourceName), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringValueToFramework(ctx, apiObject.SourceName)
+
+	// Set values for unknowns.
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, apiObject.SourceName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Source = flattenAWSLogSourceConfiguration(ctx, logSource)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findAWSLogSourceBySourceName(ctx, conn, awstypes.AwsLogSourceName(data.ID.ValueString()))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Source = flattenAWSLogSourceConfiguration(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *resourceAWSLogSource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	tflog.Debug(ctx, "deleting Security Lake AWS Log Source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	output, err := conn.DeleteAwsLogSource(ctx, &securitylake.DeleteAwsLogSourceInput{
+		Sources: []awstypes.AwsLogSourceConfiguration{expandAWSLogSourceConfiguration(ctx, data.Source)},
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceAWSLogSourceData struct {
+	ID     types.String `tfsdk:"id"`
+	Source types.List   `tfsdk:"source"`
+}
+
+type awsLogSourceConfigurationData struct {
+	Accounts      types.Set    `tfsdk:"accounts"`
+	Regions       types.Set    `tfsdk:"regions"`
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+var awsLogSourceConfigurationAttrTypes = map[string]attr.Type{
+	"accounts":       types.SetType{ElemType: types.StringType},
+	"regions":        types.SetType{ElemType: types.StringType},
+	"source_name":    types.StringType,
+	"source_version": types.StringType,
+}
+
+func expandAWSLogSourceConfiguration(ctx context.Context, tfList types.List) awstypes.AwsLogSourceConfiguration {
+	var data []awsLogSourceConfigurationData
+
+	_ = fwdiag.Must(0, tfList.ElementsAs(ctx, &data, false))
+
+	if len(data) == 0 {
+		return awstypes.AwsLogSourceConfiguration{}
+	}
+
+	apiObject := awstypes.AwsLogSourceConfiguration{
+		Regions:    flex.ExpandFrameworkStringValueSet(ctx, data[0].Regions),
+		SourceName: awstypes.AwsLogSourceName(data[0].SourceName.ValueString()),
+	}
+
+	if v := data[0].Accounts; !v.IsNull() && !v.IsUnknown() {
+		apiObject.Accounts = flex.ExpandFrameworkStringValueSet(ctx, v)
+	}
+
+	if v := data[0].SourceVersion; !v.IsNull() && !v.IsUnknown() {
+		apiObject.SourceVersion = flex.StringFromFramework(ctx, v)
+	}
+
+	return apiObject
+}
+
+func flattenAWSLogSourceConfiguration(ctx context.Context, apiObject *awstypes.AwsLogSourceConfiguration) types.List {
+	elemType := types.ObjectType{AttrTypes: awsLogSourceConfigurationAttrTypes}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(awsLogSourceConfigurationAttrTypes, map[string]attr.Value{
+			"accounts":       flex.FlattenFrameworkStringValueSet(ctx, apiObject.Accounts),
+			"regions":        flex.FlattenFrameworkStringValueSet(ctx, apiObject.Regions),
+			"source_name":    flex.StringValueToFramework(ctx, apiObject.SourceName),
+			"source_version": flex.StringToFramework(ctx, apiObject.SourceVersion),
+		}),
+	})
+}
+
+// findAWSLogSourceBySourceName returns the accounts and Regions from which the specified AWS log source is collected.
+func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName awstypes.AwsLogSourceName) (*awstypes.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName: sourceName,
+				},
+			},
+		},
+	}
+	output := &awstypes.AwsLogSourceConfiguration{
+		SourceName: sourceName,
+	}
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				v, ok := v.(*awstypes.LogSourceResourceMemberAwsLogSource)
+
+				if !ok || v.Value.SourceName != sourceName {
+					continue
+				}
+
+				if account := aws.ToString(logSource.Account); account != "" && !slices.Contains(output.Accounts, account) {
+					output.Accounts = append(output.Accounts, account)
+				}
+				if region := aws.ToString(logSource.Region); region != "" && !slices.Contains(output.Regions, region) {
+					output.Regions = append(output.Regions, region)
+				}
+				output.SourceVersion = v.Value.SourceVersion
+			}
+		}
+	}
+
+	if len(output.Regions) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Log Source")
+func newResourceCustomLogSource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceCustomLogSource{}, nil
+}
+
+type resourceCustomLogSource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceCustomLogSource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_custom_log_source"
+}
+
+func (r *resourceCustomLogSource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attributes": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: customLogSourceAttributesAttrTypes},
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_classes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"provider_details": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: customLogSourceProviderAttrTypes},
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"crawler_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"role_arn": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+						"provider_identity": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"external_id": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"principal": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceCustomLogSource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateCustomLogSourceInput{
+		Configuration: expandCustomLogSourceConfiguration(ctx, data.Configuration),
+		SourceName:    flex.StringFromFramework(ctx, data.SourceName),
+	}
+
+	if !data.EventClasses.IsNull() {
+		input.EventClasses = flex.ExpandFrameworkStringValueSet(ctx, data.EventClasses)
+	}
+
+	if !data.SourceVersion.IsUnknown() {
+		input.SourceVersion = flex.StringFromFramework(ctx, data.SourceVersion)
+	}
+
+	// The crawler role may not yet be assumable.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.AccessDeniedException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateCustomLogSource(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Custom Log Source (%s)", data.SourceName.ValueString()), err.Error())
+
+		return
+	}
+
+	output := outputRaw.(*securitylake.CreateCustomLogSourceOutput)
+
+	// Set values for unknowns.
+	data.Attributes = flattenCustomLogSourceAttributes(ctx, output.Source.Attributes)
+	data.ID = flex.StringToFramework(ctx, output.Source.SourceName)
+	data.ProviderDetails = flattenCustomLogSourceProvider(ctx, output.Source.Provider)
+	data.SourceVersion = flex.StringToFramework(ctx, output.Source.SourceVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findCustomLogSourceBySourceName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The configuration and event classes aren't returned.
+	data.Attributes = flattenCustomLogSourceAttributes(ctx, output.Attributes)
+	data.ProviderDetails = flattenCustomLogSourceProvider(ctx, output.Provider)
+	data.SourceName = flex.StringToFramework(ctx, output.SourceName)
+	data.SourceVersion = flex.StringToFramework(ctx, output.SourceVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *resourceCustomLogSource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	tflog.Debug(ctx, "deleting Security Lake Custom Log Source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteCustomLogSource(ctx, &securitylake.DeleteCustomLogSourceInput{
+		SourceName:    flex.StringFromFramework(ctx, data.ID),
+		SourceVersion: flex.StringFromFramework(ctx, data.SourceVersion),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceCustomLogSource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("source_name"), request.ID)...)
+}
+
+type resourceCustomLogSourceData struct {
+	Attributes      types.List   `tfsdk:"attributes"`
+	Configuration   types.List   `tfsdk:"configuration"`
+	EventClasses    types.Set    `tfsdk:"event_classes"`
+	ID              types.String `tfsdk:"id"`
+	ProviderDetails types.List   `tfsdk:"provider_details"`
+	SourceName      types.String `tfsdk:"source_name"`
+	SourceVersion   types.String `tfsdk:"source_version"`
+}
+
+type customLogSourceConfigurationData struct {
+	CrawlerConfiguration types.List `tfsdk:"crawler_configuration"`
+	ProviderIdentity     types.List `tfsdk:"provider_identity"`
+}
+
+type customLogSourceCrawlerConfigurationData struct {
+	RoleARN types.String `tfsdk:"role_arn"`
+}
+
+type awsIdentityData struct {
+	ExternalID types.String `tfsdk:"external_id"`
+	Principal  types.String `tfsdk:"principal"`
+}
+
+var (
+	customLogSourceAttributesAttrTypes = map[string]attr.Type{
+		"crawler_arn":  types.StringType,
+		"database_arn": types.StringType,
+		"table_arn":    types.StringType,
+	}
+
+	customLogSourceProviderAttrTypes = map[string]attr.Type{
+		"location": types.StringType,
+		"role_arn": types.StringType,
+	}
+)
+
+func expandCustomLogSourceConfiguration(ctx context.Context, tfList types.List) *awstypes.CustomLogSourceConfiguration {
+	return flex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data customLogSourceConfigurationData) *awstypes.CustomLogSourceConfiguration {
+		return &awstypes.CustomLogSourceConfiguration{
+			CrawlerConfiguration: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.CrawlerConfiguration, func(ctx context.Context, data customLogSourceCrawlerConfigurationData) *awstypes.CustomLogSourceCrawlerConfiguration {
+				return &awstypes.CustomLogSourceCrawlerConfiguration{
+					RoleArn: flex.StringFromFramework(ctx, data.RoleARN),
+				}
+			}),
+			ProviderIdentity: expandAWSIdentity(ctx, data.ProviderIdentity),
+		}
+	})
+}
+
+func expandAWSIdentity(ctx context.Context, tfList types.List) *awstypes.AwsIdentity {
+	return flex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data awsIdentityData) *awstypes.AwsIdentity {
+		return &awstypes.AwsIdentity{
+			ExternalId: flex.StringFromFramework(ctx, data.ExternalID),
+			Principal:  flex.StringFromFramework(ctx, data.Principal),
+		}
+	})
+}
+
+func flattenCustomLogSourceAttributes(ctx context.Context, apiObject *awstypes.CustomLogSourceAttributes) types.List {
+	elemType := types.ObjectType{AttrTypes: customLogSourceAttributesAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{})
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(customLogSourceAttributesAttrTypes, map[string]attr.Value{
+			"crawler_arn":  flex.StringToFramework(ctx, apiObject.CrawlerArn),
+			"database_arn": flex.StringToFramework(ctx, apiObject.DatabaseArn),
+			"table_arn":    flex.StringToFramework(ctx, apiObject.TableArn),
+		}),
+	})
+}
+
+func flattenCustomLogSourceProvider(ctx context.Context, apiObject *awstypes.CustomLogSourceProvider) types.List {
+	elemType := types.ObjectType{AttrTypes: customLogSourceProviderAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{})
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(customLogSourceProviderAttrTypes, map[string]attr.Value{
+			"location": flex.StringToFramework(ctx, apiObject.Location),
+			"role_arn": flex.StringToFramework(ctx, apiObject.RoleArn),
+		}),
+	})
+}
+
+func findCustomLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*awstypes.CustomLogSourceResource, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName: aws.String(sourceName),
+				},
+			},
+		},
+	}
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				if v, ok := v.(*awstypes.LogSourceResourceMemberCustomLogSource); ok && aws.ToString(v.Value.SourceName) == sourceName {
+					return &v.Value, nil
+				}
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
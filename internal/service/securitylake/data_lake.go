@@ -0,0 +1,672 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Data Lake")
+func newResourceDataLake(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDataLake{}
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type resourceDataLake struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceDataLake) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_data_lake"
+}
+
+func (r *resourceDataLake) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"meta_store_manager_role_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"kms_key_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"lifecycle_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"expiration": schema.ListNestedBlock{
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"transition": schema.SetNestedBlock{
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Required: true,
+												},
+												"storage_class": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"replication_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"regions": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"role_arn": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDataLake) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateDataLakeInput{
+		Configurations:          expandDataLakeConfigurations(ctx, data.Configuration),
+		MetaStoreManagerRoleArn: flex.StringFromFramework(ctx, data.MetaStoreManagerRoleARN),
+	}
+
+	// The meta store manager role may not yet be assumable.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.AccessDeniedException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateDataLake(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", err.Error())
+
+		return
+	}
+
+	output := outputRaw.(*securitylake.CreateDataLakeOutput)
+
+	if len(output.DataLakes) == 0 {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = flex.StringToFramework(ctx, output.DataLakes[0].DataLakeArn)
+	data.ID = data.ARN
+
+	dataLake, err := waitDataLakeCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.S3BucketARN = flex.StringToFramework(ctx, dataLake.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataLake) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findDataLakeByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.DataLakeArn)
+	data.Configuration = flattenDataLakeResource(ctx, output)
+	data.S3BucketARN = flex.StringToFramework(ctx, output.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataLake) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Configuration.Equal(old.Configuration) {
+		conn := r.Meta().SecurityLakeClient(ctx)
+
+		input := &securitylake.UpdateDataLakeInput{
+			Configurations: expandDataLakeConfigurations(ctx, new.Configuration),
+		}
+
+		_, err := conn.UpdateDataLake(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDataLake) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	region, err := dataLakeRegion(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Security Lake Data Lake", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err = conn.DeleteDataLake(ctx, &securitylake.DeleteDataLakeInput{
+		Regions: []string{region},
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDataLakeDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceDataLakeData struct {
+	ARN                     types.String   `tfsdk:"arn"`
+	Configuration           types.List     `tfsdk:"configuration"`
+	ID                      types.String   `tfsdk:"id"`
+	MetaStoreManagerRoleARN types.String   `tfsdk:"meta_store_manager_role_arn"`
+	S3BucketARN             types.String   `tfsdk:"s3_bucket_arn"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+type dataLakeConfigurationData struct {
+	EncryptionConfiguration  types.List   `tfsdk:"encryption_configuration"`
+	LifecycleConfiguration   types.List   `tfsdk:"lifecycle_configuration"`
+	Region                   types.String `tfsdk:"region"`
+	ReplicationConfiguration types.List   `tfsdk:"replication_configuration"`
+}
+
+type dataLakeEncryptionConfigurationData struct {
+	KMSKeyID types.String `tfsdk:"kms_key_id"`
+}
+
+type dataLakeLifecycleConfigurationData struct {
+	Expiration types.List `tfsdk:"expiration"`
+	Transition types.Set  `tfsdk:"transition"`
+}
+
+type dataLakeLifecycleExpirationData struct {
+	Days types.Int64 `tfsdk:"days"`
+}
+
+type dataLakeLifecycleTransitionData struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+type dataLakeReplicationConfigurationData struct {
+	Regions types.Set    `tfsdk:"regions"`
+	RoleARN types.String `tfsdk:"role_arn"`
+}
+
+var (
+	dataLakeEncryptionConfigurationAttrTypes = map[string]attr.Type{
+		"kms_key_id": types.StringType,
+	}
+
+	dataLakeLifecycleExpirationAttrTypes = map[string]attr.Type{
+		"days": types.Int64Type,
+	}
+
+	dataLakeLifecycleTransitionAttrTypes = map[string]attr.Type{
+		"days":          types.Int64Type,
+		"storage_class": types.StringType,
+	}
+
+	dataLakeLifecycleConfigurationAttrTypes = map[string]attr.Type{
+		"expiration": types.ListType{ElemType: types.ObjectType{AttrTypes: dataLakeLifecycleExpirationAttrTypes}},
+		"transition": types.SetType{ElemType: types.ObjectType{AttrTypes: dataLakeLifecycleTransitionAttrTypes}},
+	}
+
+	dataLakeReplicationConfigurationAttrTypes = map[string]attr.Type{
+		"regions":  types.SetType{ElemType: types.StringType},
+		"role_arn": types.StringType,
+	}
+
+	dataLakeConfigurationAttrTypes = map[string]attr.Type{
+		"encryption_configuration":  types.ListType{ElemType: types.ObjectType{AttrTypes: dataLakeEncryptionConfigurationAttrTypes}},
+		"lifecycle_configuration":   types.ListType{ElemType: types.ObjectType{AttrTypes: dataLakeLifecycleConfigurationAttrTypes}},
+		"region":                    types.StringType,
+		"replication_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: dataLakeReplicationConfigurationAttrTypes}},
+	}
+)
+
+// s3ManagedKeyID is the encryption key reported for data lakes encrypted with S3-managed keys.
+const s3ManagedKeyID = "S3_MANAGED_KEY"
+
+func expandDataLakeConfigurations(ctx context.Context, tfList types.List) []awstypes.DataLakeConfiguration {
+	return flex.ExpandFrameworkListNestedBlock(ctx, tfList, func(ctx context.Context, data dataLakeConfigurationData) awstypes.DataLakeConfiguration {
+		return awstypes.DataLakeConfiguration{
+			EncryptionConfiguration: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.EncryptionConfiguration, func(ctx context.Context, data dataLakeEncryptionConfigurationData) *awstypes.DataLakeEncryptionConfiguration {
+				return &awstypes.DataLakeEncryptionConfiguration{
+					KmsKeyId: flex.StringFromFramework(ctx, data.KMSKeyID),
+				}
+			}),
+			LifecycleConfiguration:   expandDataLakeLifecycleConfiguration(ctx, data.LifecycleConfiguration),
+			Region:                   flex.StringFromFramework(ctx, data.Region),
+			ReplicationConfiguration: expandDataLakeReplicationConfiguration(ctx, data.ReplicationConfiguration),
+		}
+	})
+}
+
+func expandDataLakeLifecycleConfiguration(ctx context.Context, tfList types.List) *awstypes.DataLakeLifecycleConfiguration {
+	return flex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data dataLakeLifecycleConfigurationData) *awstypes.DataLakeLifecycleConfiguration {
+		apiObject := &awstypes.DataLakeLifecycleConfiguration{
+			Expiration: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.Expiration, func(ctx context.Context, data dataLakeLifecycleExpirationData) *awstypes.DataLakeLifecycleExpiration {
+				return &awstypes.DataLakeLifecycleExpiration{
+					Days: aws.Int32(int32(data.Days.ValueInt64())),
+				}
+			}),
+		}
+
+		if !data.Transition.IsNull() && !data.Transition.IsUnknown() {
+			var transitions []dataLakeLifecycleTransitionData
+
+			_ = fwdiag.Must(0, data.Transition.ElementsAs(ctx, &transitions, false))
+
+			for _, v := range transitions {
+				apiObject.Transitions = append(apiObject.Transitions, awstypes.DataLakeLifecycleTransition{
+					Days:         aws.Int32(int32(v.Days.ValueInt64())),
+					StorageClass: flex.StringFromFramework(ctx, v.StorageClass),
+				})
+			}
+		}
+
+		return apiObject
+	})
+}
+
+func expandDataLakeReplicationConfiguration(ctx context.Context, tfList types.List) *awstypes.DataLakeReplicationConfiguration {
+	return flex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data dataLakeReplicationConfigurationData) *awstypes.DataLakeReplicationConfiguration {
+		return &awstypes.DataLakeReplicationConfiguration{
+			Regions: flex.ExpandFrameworkStringValueSet(ctx, data.Regions),
+			RoleArn: flex.StringFromFramework(ctx, data.RoleARN),
+		}
+	})
+}
+
+func flattenDataLakeResource(ctx context.Context, apiObject *awstypes.DataLakeResource) types.List {
+	elemType := types.ObjectType{AttrTypes: dataLakeConfigurationAttrTypes}
+
+	encryptionConfiguration := types.ListNull(types.ObjectType{AttrTypes: dataLakeEncryptionConfigurationAttrTypes})
+	if v := apiObject.EncryptionConfiguration; v != nil && aws.ToString(v.KmsKeyId) != s3ManagedKeyID {
+		encryptionConfiguration = types.ListValueMust(types.ObjectType{AttrTypes: dataLakeEncryptionConfigurationAttrTypes}, []attr.Value{
+			types.ObjectValueMust(dataLakeEncryptionConfigurationAttrTypes, map[string]attr.Value{
+				"kms_key_id": flex.StringToFramework(ctx, v.KmsKeyId),
+			}),
+		})
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(dataLakeConfigurationAttrTypes, map[string]attr.Value{
+			"encryption_configuration":  encryptionConfiguration,
+			"lifecycle_configuration":   flattenDataLakeLifecycleConfiguration(ctx, apiObject.LifecycleConfiguration),
+			"region":                    flex.StringToFramework(ctx, apiObject.Region),
+			"replication_configuration": flattenDataLakeReplicationConfiguration(ctx, apiObject.ReplicationConfiguration),
+		}),
+	})
+}
+
+func flattenDataLakeLifecycleConfiguration(ctx context.Context, apiObject *awstypes.DataLakeLifecycleConfiguration) types.List {
+	elemType := types.ObjectType{AttrTypes: dataLakeLifecycleConfigurationAttrTypes}
+
+	if apiObject == nil || (apiObject.Expiration == nil && len(apiObject.Transitions) == 0) {
+		return types.ListNull(elemType)
+	}
+
+	expiration := types.ListNull(types.ObjectType{AttrTypes: dataLakeLifecycleExpirationAttrTypes})
+	if v := apiObject.Expiration; v != nil {
+		expiration = types.ListValueMust(types.ObjectType{AttrTypes: dataLakeLifecycleExpirationAttrTypes}, []attr.Value{
+			types.ObjectValueMust(dataLakeLifecycleExpirationAttrTypes, map[string]attr.Value{
+				"days": types.Int64Value(int64(aws.ToInt32(v.Days))),
+			}),
+		})
+	}
+
+	var transitions []attr.Value
+	for _, v := range apiObject.Transitions {
+		transitions = append(transitions, types.ObjectValueMust(dataLakeLifecycleTransitionAttrTypes, map[string]attr.Value{
+			"days":          types.Int64Value(int64(aws.ToInt32(v.Days))),
+			"storage_class": flex.StringToFramework(ctx, v.StorageClass),
+		}))
+	}
+
+	transition := types.SetNull(types.ObjectType{AttrTypes: dataLakeLifecycleTransitionAttrTypes})
+	if len(transitions) > 0 {
+		transition = types.SetValueMust(types.ObjectType{AttrTypes: dataLakeLifecycleTransitionAttrTypes}, transitions)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(dataLakeLifecycleConfigurationAttrTypes, map[string]attr.Value{
+			"expiration": expiration,
+			"transition": transition,
+		}),
+	})
+}
+
+func flattenDataLakeReplicationConfiguration(ctx context.Context, apiObject *awstypes.DataLakeReplicationConfiguration) types.List {
+	elemType := types.ObjectType{AttrTypes: dataLakeReplicationConfigurationAttrTypes}
+
+	if apiObject == nil || (len(apiObject.Regions) == 0 && apiObject.RoleArn == nil) {
+		return types.ListNull(elemType)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(dataLakeReplicationConfigurationAttrTypes, map[string]attr.Value{
+			"regions":  flex.FlattenFrameworkStringValueSet(ctx, apiObject.Regions),
+			"role_arn": flex.StringToFramework(ctx, apiObject.RoleArn),
+		}),
+	})
+}
+
+// dataLakeRegion returns the Region of the data lake with the specified ARN.
+func dataLakeRegion(dataLakeARN string) (string, error) {
+	v, err := arn.Parse(dataLakeARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	return v.Region, nil
+}
+
+func findDataLakeByARN(ctx context.Context, conn *securitylake.Client, dataLakeARN string) (*awstypes.DataLakeResource, error) {
+	region, err := dataLakeRegion(dataLakeARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DataLakes {
+		if aws.ToString(v.DataLakeArn) == dataLakeARN {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func statusDataLakeCreate(ctx context.Context, conn *securitylake.Client, dataLakeARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, dataLakeARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.CreateStatus), nil
+	}
+}
+
+func statusDataLakeUpdate(ctx context.Context, conn *securitylake.Client, dataLakeARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, dataLakeARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.UpdateStatus == nil {
+			return output, string(awstypes.DataLakeStatusCompleted), nil
+		}
+
+		return output, string(output.UpdateStatus.Status), nil
+	}
+}
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending),
+		Target:     enum.Slice(awstypes.DataLakeStatusCompleted),
+		Refresh:    statusDataLakeCreate(ctx, conn, dataLakeARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending),
+		Target:     enum.Slice(awstypes.DataLakeStatusCompleted),
+		Refresh:    statusDataLakeUpdate(ctx, conn, dataLakeARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		if v := output.UpdateStatus; v != nil && v.Exception != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.Exception.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusCompleted),
+		Target:     []string{},
+		Refresh:    statusDataLakeCreate(ctx, conn, dataLakeARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSecurityLake_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"AWSLogSource": {
+			"basic":      testAccAWSLogSource_basic,
+			"disappears": testAccAWSLogSource_disappears,
+		},
+		"CustomLogSource": {
+			"basic":      testAccCustomLogSource_basic,
+			"disappears": testAccCustomLogSource_disappears,
+		},
+		"DataLake": {
+			"basic":       testAccDataLake_basic,
+			"disappears":  testAccDataLake_disappears,
+			"lifecycle":   testAccDataLake_lifecycle,
+			"replication": testAccDataLake_replication,
+		},
+		"Subscriber": {
+			"basic":      testAccSubscriber_basic,
+			"disappears": testAccSubscriber_disappears,
+			"update":     testAccSubscriber_update,
+		},
+		"SubscriberNotification": {
+			"https":      testAccSubscriberNotification_https,
+			"sqs":        testAccSubscriberNotification_sqs,
+			"disappears": testAccSubscriberNotification_disappears,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
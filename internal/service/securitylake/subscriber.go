@@ -0,0 +1,587 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber")
+func newResourceSubscriber(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceSubscriber{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceSubscriber struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceSubscriber) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber"
+}
+
+func (r *resourceSubscriber) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	logSourceResourceBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"source_name": schema.StringAttribute{
+						Required: true,
+					},
+					"source_version": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.AccessType](),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"resource_share_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_share_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriber_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_name": schema.StringAttribute{
+				Required: true,
+			},
+			"subscriber_status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.SetNestedBlock{
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_log_source_resource":    logSourceResourceBlock(),
+						"custom_log_source_resource": logSourceResourceBlock(),
+					},
+				},
+			},
+			"subscriber_identity": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceSubscriber) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateSubscriberInput{
+		Sources:               expandLogSourceResources(ctx, data.Source),
+		SubscriberDescription: flex.StringFromFramework(ctx, data.SubscriberDescription),
+		SubscriberIdentity:    expandAWSIdentity(ctx, data.SubscriberIdentity),
+		SubscriberName:        flex.StringFromFramework(ctx, data.SubscriberName),
+	}
+
+	if v := data.AccessType; !v.IsNull() && !v.IsUnknown() {
+		input.AccessTypes = []awstypes.AccessType{awstypes.AccessType(v.ValueString())}
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber (%s)", data.SubscriberName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Subscriber.SubscriberId)
+
+	subscriber, err := waitSubscriberCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.refreshFromOutput(ctx, subscriber)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Source.Equal(old.Source) ||
+		!new.SubscriberDescription.Equal(old.SubscriberDescription) ||
+		!new.SubscriberIdentity.Equal(old.SubscriberIdentity) ||
+		!new.SubscriberName.Equal(old.SubscriberName) {
+		input := &securitylake.UpdateSubscriberInput{
+			Sources:               expandLogSourceResources(ctx, new.Source),
+			SubscriberDescription: flex.StringFromFramework(ctx, new.SubscriberDescription),
+			SubscriberId:          flex.StringFromFramework(ctx, new.ID),
+			SubscriberIdentity:    expandAWSIdentity(ctx, new.SubscriberIdentity),
+			SubscriberName:        flex.StringFromFramework(ctx, new.SubscriberName),
+		}
+
+		_, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	subscriber, err := waitSubscriberUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) update", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.refreshFromOutput(ctx, subscriber)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSubscriber) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	tflog.Debug(ctx, "deleting Security Lake Subscriber", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitSubscriberDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceSubscriberData struct {
+	AccessType            types.String   `tfsdk:"access_type"`
+	ARN                   types.String   `tfsdk:"arn"`
+	ID                    types.String   `tfsdk:"id"`
+	ResourceShareARN      types.String   `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String   `tfsdk:"resource_share_name"`
+	RoleARN               types.String   `tfsdk:"role_arn"`
+	S3BucketARN           types.String   `tfsdk:"s3_bucket_arn"`
+	Source                types.Set      `tfsdk:"source"`
+	SubscriberDescription types.String   `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String   `tfsdk:"subscriber_endpoint"`
+	SubscriberIdentity    types.List     `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String   `tfsdk:"subscriber_name"`
+	SubscriberStatus      types.String   `tfsdk:"subscriber_status"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *resourceSubscriberData) refreshFromOutput(ctx context.Context, apiObject *awstypes.SubscriberResource) {
+	if len(apiObject.AccessTypes) > 0 {
+		data.AccessType = flex.StringValueToFramework(ctx, apiObject.AccessTypes[0])
+	} else {
+		data.AccessType = types.StringNull()
+	}
+	data.ARN = flex.StringToFramework(ctx, apiObject.SubscriberArn)
+	data.ResourceShareARN = flex.StringToFramework(ctx, apiObject.ResourceShareArn)
+	data.ResourceShareName = flex.StringToFramework(ctx, apiObject.ResourceShareName)
+	data.RoleARN = flex.StringToFramework(ctx, apiObject.RoleArn)
+	data.S3BucketARN = flex.StringToFramework(ctx, apiObject.S3BucketArn)
+	data.Source = flattenLogSourceResources(ctx, apiObject.Sources)
+	data.SubscriberDescription = flex.StringToFramework(ctx, apiObject.SubscriberDescription)
+	data.SubscriberEndpoint = flex.StringToFramework(ctx, apiObject.SubscriberEndpoint)
+	data.SubscriberIdentity = flattenAWSIdentity(ctx, apiObject.SubscriberIdentity)
+	data.SubscriberName = flex.StringToFramework(ctx, apiObject.SubscriberName)
+	data.SubscriberStatus = flex.StringValueToFramework(ctx, apiObject.SubscriberStatus)
+}
+
+type logSourceResourceData struct {
+	AWSLogSourceResource    types.List `tfsdk:"aws_log_source_resource"`
+	CustomLogSourceResource types.List `tfsdk:"custom_log_source_resource"`
+}
+
+type logSourceResourceDetailData struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+var (
+	awsIdentityAttrTypes = map[string]attr.Type{
+		"external_id": types.StringType,
+		"principal":   types.StringType,
+	}
+
+	logSourceResourceDetailAttrTypes = map[string]attr.Type{
+		"source_name":    types.StringType,
+		"source_version": types.StringType,
+	}
+
+	logSourceResourceAttrTypes = map[string]attr.Type{
+		"aws_log_source_resource":    types.ListType{ElemType: types.ObjectType{AttrTypes: logSourceResourceDetailAttrTypes}},
+		"custom_log_source_resource": types.ListType{ElemType: types.ObjectType{AttrTypes: logSourceResourceDetailAttrTypes}},
+	}
+)
+
+func expandLogSourceResources(ctx context.Context, tfSet types.Set) []awstypes.LogSourceResource {
+	var data []logSourceResourceData
+
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil
+	}
+
+	_ = fwdiag.Must(0, tfSet.ElementsAs(ctx, &data, false))
+
+	var apiObjects []awstypes.LogSourceResource
+
+	for _, v := range data {
+		if v := expandLogSourceResourceDetail(ctx, v.AWSLogSourceResource); v != nil {
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName:    awstypes.AwsLogSourceName(aws.ToString(v.SourceName)),
+					SourceVersion: v.SourceVersion,
+				},
+			})
+		}
+
+		if v := expandLogSourceResourceDetail(ctx, v.CustomLogSourceResource); v != nil {
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: *v,
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func expandLogSourceResourceDetail(ctx context.Context, tfList types.List) *awstypes.CustomLogSourceResource {
+	return flex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data logSourceResourceDetailData) *awstypes.CustomLogSourceResource {
+		apiObject := &awstypes.CustomLogSourceResource{
+			SourceName: flex.StringFromFramework(ctx, data.SourceName),
+		}
+
+		if !data.SourceVersion.IsUnknown() {
+			apiObject.SourceVersion = flex.StringFromFramework(ctx, data.SourceVersion)
+		}
+
+		return apiObject
+	})
+}
+
+func flattenLogSourceResources(ctx context.Context, apiObjects []awstypes.LogSourceResource) types.Set {
+	elemType := types.ObjectType{AttrTypes: logSourceResourceAttrTypes}
+	detailElemType := types.ObjectType{AttrTypes: logSourceResourceDetailAttrTypes}
+
+	var elems []attr.Value
+
+	for _, apiObject := range apiObjects {
+		awsLogSourceResource := types.ListValueMust(detailElemType, []attr.Value{})
+		customLogSourceResource := types.ListValueMust(detailElemType, []attr.Value{})
+
+		switch v := apiObject.(type) {
+		case *awstypes.LogSourceResourceMemberAwsLogSource:
+			awsLogSourceResource = types.ListValueMust(detailElemType, []attr.Value{
+				types.ObjectValueMust(logSourceResourceDetailAttrTypes, map[string]attr.Value{
+					"source_name":    flex.StringValueToFramework(ctx, v.Value.SourceName),
+					"source_version": flex.StringToFramework(ctx, v.Value.SourceVersion),
+				}),
+			})
+		case *awstypes.LogSourceResourceMemberCustomLogSource:
+			customLogSourceResource = types.ListValueMust(detailElemType, []attr.Value{
+				types.ObjectValueMust(logSourceResourceDetailAttrTypes, map[string]attr.Value{
+					"source_name":    flex.StringToFramework(ctx, v.Value.SourceName),
+					"source_version": flex.StringToFramework(ctx, v.Value.SourceVersion),
+				}),
+			})
+		default:
+			continue
+		}
+
+		elems = append(elems, types.ObjectValueMust(logSourceResourceAttrTypes, map[string]attr.Value{
+			"aws_log_source_resource":    awsLogSourceResource,
+			"custom_log_source_resource": customLogSourceResource,
+		}))
+	}
+
+	return types.SetValueMust(elemType, elems)
+}
+
+func flattenAWSIdentity(ctx context.Context, apiObject *awstypes.AwsIdentity) types.List {
+	elemType := types.ObjectType{AttrTypes: awsIdentityAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(awsIdentityAttrTypes, map[string]attr.Value{
+			"external_id": flex.StringToFramework(ctx, apiObject.ExternalId),
+			"principal":   flex.StringToFramework(ctx, apiObject.Principal),
+		}),
+	})
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*awstypes.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscriberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SubscriberStatus), nil
+	}
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SubscriberStatusPending),
+		Target:     enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh:    statusSubscriber(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SubscriberStatusPending),
+		Target:     enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh:    statusSubscriber(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusDeactivated, awstypes.SubscriberStatusPending, awstypes.SubscriberStatusReady),
+		Target:  []string{},
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber Notification")
+func newResourceSubscriberNotification(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSubscriberNotification{}, nil
+}
+
+type resourceSubscriberNotification struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceSubscriberNotification) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber_notification"
+}
+
+func (r *resourceSubscriberNotification) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"subscriber_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"subscriber_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"https_notification_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("https_notification_configuration"),
+									path.MatchRelative().AtParent().AtName("sqs_notification_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authorization_api_key_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"authorization_api_key_value": schema.StringAttribute{
+										Optional:  true,
+										Sensitive: true,
+									},
+									"endpoint": schema.StringAttribute{
+										Required: true,
+									},
+									"http_method": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[awstypes.HttpMethod](),
+										},
+									},
+									"target_role_arn": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"sqs_notification_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSubscriberNotification) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateSubscriberNotificationInput{
+		Configuration: expandNotificationConfiguration(ctx, data.Configuration),
+		SubscriberId:  flex.StringFromFramework(ctx, data.SubscriberID),
+	}
+
+	output, err := conn.CreateSubscriberNotification(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber Notification (%s)", data.SubscriberID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.SubscriberID
+	data.SubscriberEndpoint = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriberNotification) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if err == nil && output.SubscriberEndpoint == nil {
+		err = tfresource.NewEmptyResultError(data.ID.ValueString())
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The notification configuration is not returned by the API.
+	data.SubscriberEndpoint = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	data.SubscriberID = flex.StringToFramework(ctx, output.SubscriberId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriberNotification) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.UpdateSubscriberNotificationInput{
+		Configuration: expandNotificationConfiguration(ctx, data.Configuration),
+		SubscriberId:  flex.StringFromFramework(ctx, data.ID),
+	}
+
+	output, err := conn.UpdateSubscriberNotification(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.SubscriberEndpoint = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriberNotification) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	tflog.Debug(ctx, "deleting Security Lake Subscriber Notification", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteSubscriberNotification(ctx, &securitylake.DeleteSubscriberNotificationInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceSubscriberNotificationData struct {
+	Configuration      types.List   `tfsdk:"configuration"`
+	ID                 types.String `tfsdk:"id"`
+	SubscriberEndpoint types.String `tfsdk:"subscriber_endpoint"`
+	SubscriberID       types.String `tfsdk:"subscriber_id"`
+}
+
+type notificationConfigurationData struct {
+	HTTPSNotificationConfiguration types.List `tfsdk:"https_notification_configuration"`
+	SQSNotificationConfiguration   types.List `tfsdk:"sqs_notification_configuration"`
+}
+
+type httpsNotificationConfigurationData struct {
+	AuthorizationAPIKeyName  types.String `tfsdk:"authorization_api_key_name"`
+	AuthorizationAPIKeyValue types.String `tfsdk:"authorization_api_key_value"`
+	Endpoint                 types.String `tfsdk:"endpoint"`
+	HTTPMethod               types.String `tfsdk:"http_method"`
+	TargetRoleARN            types.String `tfsdk:"target_role_arn"`
+}
+
+func expandNotificationConfiguration(ctx context.Context, tfList types.List) awstypes.NotificationConfiguration {
+	var data []notificationConfigurationData
+
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil
+	}
+
+	_ = fwdiag.Must(0, tfList.ElementsAs(ctx, &data, false))
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	if v := data[0].HTTPSNotificationConfiguration; !v.IsNull() && len(v.Elements()) > 0 {
+		var https []httpsNotificationConfigurationData
+
+		_ = fwdiag.Must(0, v.ElementsAs(ctx, &https, false))
+
+		return &awstypes.NotificationConfigurationMemberHttpsNotificationConfiguration{
+			Value: awstypes.HttpsNotificationConfiguration{
+				AuthorizationApiKeyName:  flex.StringFromFramework(ctx, https[0].AuthorizationAPIKeyName),
+				AuthorizationApiKeyValue: flex.StringFromFramework(ctx, https[0].AuthorizationAPIKeyValue),
+				Endpoint:                 flex.StringFromFramework(ctx, https[0].Endpoint),
+				HttpMethod:               awstypes.HttpMethod(https[0].HTTPMethod.ValueString()),
+				TargetRoleArn:            flex.StringFromFramework(ctx, https[0].TargetRoleARN),
+			},
+		}
+	}
+
+	if v := data[0].SQSNotificationConfiguration; !v.IsNull() && len(v.Elements()) > 0 {
+		return &awstypes.NotificationConfigurationMemberSqsNotificationConfiguration{
+			Value: awstypes.SqsNotificationConfiguration{},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package securitylake
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	// Deleting a data lake also deletes its AWS log sources.
+	resource.AddTestSweepers("aws_securitylake_data_lake", &resource.Sweeper{
+		Name: "aws_securitylake_data_lake",
+		F:    sweepDataLakes,
+		Dependencies: []string{
+			"aws_securitylake_custom_log_source",
+			"aws_securitylake_subscriber",
+		},
+	})
+
+	resource.AddTestSweepers("aws_securitylake_custom_log_source", &resource.Sweeper{
+		Name: "aws_securitylake_custom_log_source",
+		F:    sweepCustomLogSources,
+	})
+
+	resource.AddTestSweepers("aws_securitylake_subscriber", &resource.Sweeper{
+		Name: "aws_securitylake_subscriber",
+		F:    sweepSubscribers,
+	})
+}
+
+func sweepDataLakes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.SecurityLakeClient(ctx)
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Security Lake Data Lake sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Security Lake Data Lakes (%s): %w", region, err)
+	}
+
+	for _, v := range output.DataLakes {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newResourceDataLake, client,
+			framework.NewAttribute("id", aws.ToString(v.DataLakeArn)),
+		))
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Security Lake Data Lakes (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepCustomLogSources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.SecurityLakeClient(ctx)
+	input := &securitylake.ListLogSourcesInput{
+		Regions: []string{region},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Security Lake Custom Log Source sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Security Lake Log Sources (%s): %w", region, err)
+		}
+
+		for _, v := range page.Sources {
+			for _, v := range v.Sources {
+				v, ok := v.(*awstypes.LogSourceResourceMemberCustomLogSource)
+
+				if !ok {
+					continue
+				}
+
+				sourceName := aws.ToString(v.Value.SourceName)
+				sweepResources = append(sweepResources, framework.NewSweepResource(newResourceCustomLogSource, client,
+					framework.NewAttribute("id", sourceName),
+					framework.NewAttribute("source_name", sourceName),
+				))
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Security Lake Custom Log Sources (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSubscribers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.SecurityLakeClient(ctx)
+	input := &securitylake.ListSubscribersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := securitylake.NewListSubscribersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Security Lake Subscriber sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Security Lake Subscribers (%s): %w", region, err)
+		}
+
+		for _, v := range page.Subscribers {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceSubscriber, client,
+				framework.NewAttribute("id", aws.ToString(v.SubscriberId)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Security Lake Subscribers (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Parameter")
+func newEphemeralParameter(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralParameter{}, nil
+}
+
+type ephemeralParameter struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralParameter) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ssm_parameter"
+}
+
+func (e *ephemeralParameter) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+			},
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralParameter) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralParameterData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSMConn(ctx)
+
+	// SecureString parameters are decrypted unless explicitly requested otherwise.
+	if data.WithDecryption.IsNull() {
+		data.WithDecryption = types.BoolValue(true)
+	}
+
+	name := data.ARN.ValueString()
+	output, err := conn.GetParameterWithContext(ctx, &ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(data.WithDecryption.ValueBool()),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameter (%s)", name), err.Error())
+
+		return
+	}
+
+	parameter := output.Parameter
+	data.ARN = flex.StringToFramework(ctx, parameter.ARN)
+	data.Name = flex.StringToFramework(ctx, parameter.Name)
+	data.Type = flex.StringToFramework(ctx, parameter.Type)
+	data.Value = flex.StringToFramework(ctx, parameter.Value)
+	data.Version = flex.Int64ToFramework(ctx, parameter.Version)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralParameterData struct {
+	ARN            types.String `tfsdk:"arn"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Value          types.String `tfsdk:"value"`
+	Version        types.Int64  `tfsdk:"version"`
+	WithDecryption types.Bool   `tfsdk:"with_decryption"`
+}
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_ssmincidents_replication_set")
+// @Region(overrideEnabled=false)
 func DataSourceReplicationSet() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceReplicationSetRead,
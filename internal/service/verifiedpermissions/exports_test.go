@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+// Exports for use in tests only.
+var (
+	FindIdentitySourceByTwoPartKey = findIdentitySourceByTwoPartKey
+	FindPolicyByTwoPartKey         = findPolicyByTwoPartKey
+	FindPolicyStoreByID            = findPolicyStoreByID
+	FindPolicyTemplateByTwoPartKey = findPolicyTemplateByTwoPartKey
+	FindSchemaByPolicyStoreID      = findSchemaByPolicyStoreID
+	ResourceIdentitySource         = newResourceIdentitySource
+	ResourcePolicy                 = newResourcePolicy
+	ResourcePolicyStore            = newResourcePolicyStore
+	ResourcePolicyTemplate         = newResourcePolicyTemplate
+	ResourceSchema                 = newResourceSchema
+)
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+// @IdentityAttribute("policy_store_id")
+// @IdentityAttribute("identity_source_id")
+func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceIdentitySource{}, nil
+}
+
+type resourceIdentitySource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *resourceIdentitySource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"identity_source_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cognito_user_pool_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"client_ids": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"user_pool_arn": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIdentitySource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken:         aws.String(id.UniqueId()),
+		PolicyStoreId:       fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+		PrincipalEntityType: fwflex.StringFromFramework(ctx, data.PrincipalEntityType),
+	}
+
+	if v := expandCognitoUserPoolConfiguration(ctx, data.Configuration); v != nil {
+		input.Configuration = &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{
+			Value: *v,
+		}
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Identity Source (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.ToString(output.PolicyStoreId), aws.ToString(output.IdentitySourceId)}, identitySourceResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Permissions Identity Source", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+	data.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+
+	identitySource, err := findIdentitySourceByTwoPartKey(ctx, conn, aws.ToString(output.PolicyStoreId), aws.ToString(output.IdentitySourceId))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Identity Source (%s)", id), err.Error())
+
+		return
+	}
+
+	data.PrincipalEntityType = fwflex.StringToFramework(ctx, identitySource.PrincipalEntityType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIdentitySource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	policyStoreID, identitySourceID, err := identitySourceParseResourceID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	output, err := findIdentitySourceByTwoPartKey(ctx, conn, policyStoreID, identitySourceID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Configuration = flattenIdentitySourceDetails(ctx, output.Details)
+	data.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+	data.PolicyStoreID = fwflex.StringToFramework(ctx, output.PolicyStoreId)
+	data.PrincipalEntityType = fwflex.StringToFramework(ctx, output.PrincipalEntityType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIdentitySource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Configuration.Equal(old.Configuration) || !new.PrincipalEntityType.Equal(old.PrincipalEntityType) {
+		conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId:    fwflex.StringFromFramework(ctx, new.IdentitySourceID),
+			PolicyStoreId:       fwflex.StringFromFramework(ctx, new.PolicyStoreID),
+			PrincipalEntityType: fwflex.StringFromFramework(ctx, new.PrincipalEntityType),
+		}
+
+		if v := expandCognitoUserPoolConfiguration(ctx, new.Configuration); v != nil {
+			input.UpdateConfiguration = &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{
+				Value: awstypes.UpdateCognitoUserPoolConfiguration{
+					ClientIds:   v.ClientIds,
+					UserPoolArn: v.UserPoolArn,
+				},
+			}
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Identity Source (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	tflog.Debug(ctx, "deleting Verified Permissions Identity Source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteIdentitySource(ctx, &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: fwflex.StringFromFramework(ctx, data.IdentitySourceID),
+		PolicyStoreId:    fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceIdentitySource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	policyStoreID, identitySourceID, err := identitySourceParseResourceID(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("identity_source_id"), identitySourceID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("policy_store_id"), policyStoreID)...)
+}
+
+type resourceIdentitySourceData struct {
+	Configuration       types.List   `tfsdk:"configuration"`
+	ID                  types.String `tfsdk:"id"`
+	IdentitySourceID    types.String `tfsdk:"identity_source_id"`
+	PolicyStoreID       types.String `tfsdk:"policy_store_id"`
+	PrincipalEntityType types.String `tfsdk:"principal_entity_type"`
+}
+
+type identitySourceConfigurationData struct {
+	CognitoUserPoolConfiguration types.List `tfsdk:"cognito_user_pool_configuration"`
+}
+
+type cognitoUserPoolConfigurationData struct {
+	ClientIDs   types.Set    `tfsdk:"client_ids"`
+	UserPoolARN types.String `tfsdk:"user_pool_arn"`
+}
+
+var (
+	cognitoUserPoolConfigurationAttrTypes = map[string]attr.Type{
+		"client_ids":    types.SetType{ElemType: types.StringType},
+		"user_pool_arn": types.StringType,
+	}
+
+	identitySourceConfigurationAttrTypes = map[string]attr.Type{
+		"cognito_user_pool_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: cognitoUserPoolConfigurationAttrTypes}},
+	}
+)
+
+const identitySourceResourceIDPartCount = 2
+
+func identitySourceParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, identitySourceResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandCognitoUserPoolConfiguration(ctx context.Context, tfList types.List) *awstypes.CognitoUserPoolConfiguration {
+	var data []identitySourceConfigurationData
+
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil
+	}
+
+	_ = fwdiag.Must(0, tfList.ElementsAs(ctx, &data, false))
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	return fwflex.ExpandFrameworkListNestedBlockPtr(ctx, data[0].CognitoUserPoolConfiguration, func(ctx context.Context, data cognitoUserPoolConfigurationData) *awstypes.CognitoUserPoolConfiguration {
+		apiObject := &awstypes.CognitoUserPoolConfiguration{
+			UserPoolArn: fwflex.StringFromFramework(ctx, data.UserPoolARN),
+		}
+
+		if !data.ClientIDs.IsNull() {
+			apiObject.ClientIds = fwflex.ExpandFrameworkStringValueSet(ctx, data.ClientIDs)
+		}
+
+		return apiObject
+	})
+}
+
+func flattenIdentitySourceDetails(ctx context.Context, apiObject *awstypes.IdentitySourceDetails) types.List {
+	elemType := types.ObjectType{AttrTypes: identitySourceConfigurationAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType)
+	}
+
+	clientIDs := types.SetNull(types.StringType)
+	if len(apiObject.ClientIds) > 0 {
+		clientIDs = fwflex.FlattenFrameworkStringValueSet(ctx, apiObject.ClientIds)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(identitySourceConfigurationAttrTypes, map[string]attr.Value{
+			"cognito_user_pool_configuration": types.ListValueMust(types.ObjectType{AttrTypes: cognitoUserPoolConfigurationAttrTypes}, []attr.Value{
+				types.ObjectValueMust(cognitoUserPoolConfigurationAttrTypes, map[string]attr.Value{
+					"client_ids":    clientIDs,
+					"user_pool_arn": fwflex.StringToFramework(ctx, apiObject.UserPoolArn),
+				}),
+			}),
+		}),
+	})
+}
+
+func findIdentitySourceByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, identitySourceID string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	input := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(identitySourceID),
+		PolicyStoreId:    aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetIdentitySource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IdentitySourceId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,531 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy")
+// @IdentityAttribute("policy_store_id")
+// @IdentityAttribute("policy_id")
+func newResourcePolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePolicy{}, nil
+}
+
+type resourcePolicy struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourcePolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy"
+}
+
+func (r *resourcePolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"entity_id": schema.StringAttribute{
+						Required: true,
+					},
+					"entity_type": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_date": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"static": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("static"),
+									path.MatchRelative().AtParent().AtName("template_linked"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"description": schema.StringAttribute{
+										Optional: true,
+									},
+									"statement": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						// A template-linked policy can't be updated in place.
+						"template_linked": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"policy_template_id": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"principal": entityIdentifierBlock(),
+									"resource":  entityIdentifierBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Definition:    expandPolicyDefinition(ctx, data.Definition),
+		PolicyStoreId: fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Policy (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.ToString(output.PolicyStoreId), aws.ToString(output.PolicyId)}, policyResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Permissions Policy", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CreatedDate = fwflex.StringValueToFramework(ctx, aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	data.ID = types.StringValue(id)
+	data.PolicyID = fwflex.StringToFramework(ctx, output.PolicyId)
+	data.PolicyType = fwflex.StringValueToFramework(ctx, output.PolicyType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	policyStoreID, policyID, err := policyParseResourceID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	output, err := findPolicyByTwoPartKey(ctx, conn, policyStoreID, policyID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.CreatedDate = fwflex.StringValueToFramework(ctx, aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	data.Definition = flattenPolicyDefinitionDetail(ctx, output.Definition)
+	data.PolicyID = fwflex.StringToFramework(ctx, output.PolicyId)
+	data.PolicyStoreID = fwflex.StringToFramework(ctx, output.PolicyStoreId)
+	data.PolicyType = fwflex.StringValueToFramework(ctx, output.PolicyType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Definition.Equal(old.Definition) {
+		conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+		// Only static policies can be updated; any change to a template-linked policy forces replacement.
+		definition, ok := expandPolicyDefinition(ctx, new.Definition).(*awstypes.PolicyDefinitionMemberStatic)
+
+		if !ok {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Policy (%s)", new.ID.ValueString()), "only static policies can be updated")
+
+			return
+		}
+
+		input := &verifiedpermissions.UpdatePolicyInput{
+			Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+				Value: awstypes.UpdateStaticPolicyDefinition{
+					Description: definition.Value.Description,
+					Statement:   definition.Value.Statement,
+				},
+			},
+			PolicyId:      fwflex.StringFromFramework(ctx, new.PolicyID),
+			PolicyStoreId: fwflex.StringFromFramework(ctx, new.PolicyStoreID),
+		}
+
+		_, err := conn.UpdatePolicy(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Policy (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourcePolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	tflog.Debug(ctx, "deleting Verified Permissions Policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeletePolicy(ctx, &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      fwflex.StringFromFramework(ctx, data.PolicyID),
+		PolicyStoreId: fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourcePolicy) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	policyStoreID, policyID, err := policyParseResourceID(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("policy_id"), policyID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("policy_store_id"), policyStoreID)...)
+}
+
+type resourcePolicyData struct {
+	CreatedDate   types.String `tfsdk:"created_date"`
+	Definition    types.List   `tfsdk:"definition"`
+	ID            types.String `tfsdk:"id"`
+	PolicyID      types.String `tfsdk:"policy_id"`
+	PolicyStoreID types.String `tfsdk:"policy_store_id"`
+	PolicyType    types.String `tfsdk:"policy_type"`
+}
+
+type policyDefinitionData struct {
+	Static         types.List `tfsdk:"static"`
+	TemplateLinked types.List `tfsdk:"template_linked"`
+}
+
+type staticPolicyDefinitionData struct {
+	Description types.String `tfsdk:"description"`
+	Statement   types.String `tfsdk:"statement"`
+}
+
+type templateLinkedPolicyDefinitionData struct {
+	PolicyTemplateID types.String `tfsdk:"policy_template_id"`
+	Principal        types.List   `tfsdk:"principal"`
+	Resource         types.List   `tfsdk:"resource"`
+}
+
+type entityIdentifierData struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+var (
+	entityIdentifierAttrTypes = map[string]attr.Type{
+		"entity_id":   types.StringType,
+		"entity_type": types.StringType,
+	}
+
+	staticPolicyDefinitionAttrTypes = map[string]attr.Type{
+		"description": types.StringType,
+		"statement":   types.StringType,
+	}
+
+	templateLinkedPolicyDefinitionAttrTypes = map[string]attr.Type{
+		"policy_template_id": types.StringType,
+		"principal":          types.ListType{ElemType: types.ObjectType{AttrTypes: entityIdentifierAttrTypes}},
+		"resource":           types.ListType{ElemType: types.ObjectType{AttrTypes: entityIdentifierAttrTypes}},
+	}
+
+	policyDefinitionAttrTypes = map[string]attr.Type{
+		"static":          types.ListType{ElemType: types.ObjectType{AttrTypes: staticPolicyDefinitionAttrTypes}},
+		"template_linked": types.ListType{ElemType: types.ObjectType{AttrTypes: templateLinkedPolicyDefinitionAttrTypes}},
+	}
+)
+
+const policyResourceIDPartCount = 2
+
+func policyParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, policyResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandPolicyDefinition(ctx context.Context, tfList types.List) awstypes.PolicyDefinition {
+	var data []policyDefinitionData
+
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil
+	}
+
+	_ = fwdiag.Must(0, tfList.ElementsAs(ctx, &data, false))
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	if !data[0].Static.IsNull() {
+		var static []staticPolicyDefinitionData
+
+		_ = fwdiag.Must(0, data[0].Static.ElementsAs(ctx, &static, false))
+
+		if len(static) > 0 {
+			return &awstypes.PolicyDefinitionMemberStatic{
+				Value: awstypes.StaticPolicyDefinition{
+					Description: fwflex.StringFromFramework(ctx, static[0].Description),
+					Statement:   fwflex.StringFromFramework(ctx, static[0].Statement),
+				},
+			}
+		}
+	}
+
+	if !data[0].TemplateLinked.IsNull() {
+		var templateLinked []templateLinkedPolicyDefinitionData
+
+		_ = fwdiag.Must(0, data[0].TemplateLinked.ElementsAs(ctx, &templateLinked, false))
+
+		if len(templateLinked) > 0 {
+			return &awstypes.PolicyDefinitionMemberTemplateLinked{
+				Value: awstypes.TemplateLinkedPolicyDefinition{
+					PolicyTemplateId: fwflex.StringFromFramework(ctx, templateLinked[0].PolicyTemplateID),
+					Principal:        expandEntityIdentifier(ctx, templateLinked[0].Principal),
+					Resource:         expandEntityIdentifier(ctx, templateLinked[0].Resource),
+				},
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandEntityIdentifier(ctx context.Context, tfList types.List) *awstypes.EntityIdentifier {
+	return fwflex.ExpandFrameworkListNestedBlockPtr(ctx, tfList, func(ctx context.Context, data entityIdentifierData) *awstypes.EntityIdentifier {
+		return &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, data.EntityID),
+			EntityType: fwflex.StringFromFramework(ctx, data.EntityType),
+		}
+	})
+}
+
+func flattenPolicyDefinitionDetail(ctx context.Context, apiObject awstypes.PolicyDefinitionDetail) types.List {
+	elemType := types.ObjectType{AttrTypes: policyDefinitionAttrTypes}
+
+	static := types.ListNull(types.ObjectType{AttrTypes: staticPolicyDefinitionAttrTypes})
+	templateLinked := types.ListNull(types.ObjectType{AttrTypes: templateLinkedPolicyDefinitionAttrTypes})
+
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyDefinitionDetailMemberStatic:
+		static = types.ListValueMust(types.ObjectType{AttrTypes: staticPolicyDefinitionAttrTypes}, []attr.Value{
+			types.ObjectValueMust(staticPolicyDefinitionAttrTypes, map[string]attr.Value{
+				"description": fwflex.StringToFramework(ctx, v.Value.Description),
+				"statement":   fwflex.StringToFramework(ctx, v.Value.Statement),
+			}),
+		})
+	case *awstypes.PolicyDefinitionDetailMemberTemplateLinked:
+		templateLinked = types.ListValueMust(types.ObjectType{AttrTypes: templateLinkedPolicyDefinitionAttrTypes}, []attr.Value{
+			types.ObjectValueMust(templateLinkedPolicyDefinitionAttrTypes, map[string]attr.Value{
+				"policy_template_id": fwflex.StringToFramework(ctx, v.Value.PolicyTemplateId),
+				"principal":          flattenEntityIdentifier(ctx, v.Value.Principal),
+				"resource":           flattenEntityIdentifier(ctx, v.Value.Resource),
+			}),
+		})
+	default:
+		return types.ListNull(elemType)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(policyDefinitionAttrTypes, map[string]attr.Value{
+			"static":          static,
+			"template_linked": templateLinked,
+		}),
+	})
+}
+
+func flattenEntityIdentifier(ctx context.Context, apiObject *awstypes.EntityIdentifier) types.List {
+	elemType := types.ObjectType{AttrTypes: entityIdentifierAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType)
+	}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(entityIdentifierAttrTypes, map[string]attr.Value{
+			"entity_id":   fwflex.StringToFramework(ctx, apiObject.EntityId),
+			"entity_type": fwflex.StringToFramework(ctx, apiObject.EntityType),
+		}),
+	})
+}
+
+func findPolicyByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) (*verifiedpermissions.GetPolicyOutput, error) {
+	input := &verifiedpermissions.GetPolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PolicyId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy Template")
+// @IdentityAttribute("policy_store_id")
+// @IdentityAttribute("policy_template_id")
+func newResourcePolicyTemplate(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePolicyTemplate{}, nil
+}
+
+type resourcePolicyTemplate struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePolicyTemplate) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy_template"
+}
+
+func (r *resourcePolicyTemplate) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_date": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 150),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"statement": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicyTemplate) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourcePolicyTemplateData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.CreatePolicyTemplateInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Description:   fwflex.StringFromFramework(ctx, data.Description),
+		PolicyStoreId: fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+		Statement:     fwflex.StringFromFramework(ctx, data.Statement),
+	}
+
+	output, err := conn.CreatePolicyTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Policy Template (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.ToString(output.PolicyStoreId), aws.ToString(output.PolicyTemplateId)}, policyTemplateResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Permissions Policy Template", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CreatedDate = fwflex.StringValueToFramework(ctx, aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	data.ID = types.StringValue(id)
+	data.PolicyTemplateID = fwflex.StringToFramework(ctx, output.PolicyTemplateId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicyTemplate) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourcePolicyTemplateData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	policyStoreID, policyTemplateID, err := policyTemplateParseResourceID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	output, err := findPolicyTemplateByTwoPartKey(ctx, conn, policyStoreID, policyTemplateID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Policy Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.CreatedDate = fwflex.StringValueToFramework(ctx, aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.PolicyStoreID = fwflex.StringToFramework(ctx, output.PolicyStoreId)
+	data.PolicyTemplateID = fwflex.StringToFramework(ctx, output.PolicyTemplateId)
+	data.Statement = fwflex.StringToFramework(ctx, output.Statement)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicyTemplate) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourcePolicyTemplateData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Description.Equal(old.Description) || !new.Statement.Equal(old.Statement) {
+		conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+		input := &verifiedpermissions.UpdatePolicyTemplateInput{
+			Description:      fwflex.StringFromFramework(ctx, new.Description),
+			PolicyStoreId:    fwflex.StringFromFramework(ctx, new.PolicyStoreID),
+			PolicyTemplateId: fwflex.StringFromFramework(ctx, new.PolicyTemplateID),
+			Statement:        fwflex.StringFromFramework(ctx, new.Statement),
+		}
+
+		_, err := conn.UpdatePolicyTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Policy Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourcePolicyTemplate) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourcePolicyTemplateData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	tflog.Debug(ctx, "deleting Verified Permissions Policy Template", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeletePolicyTemplate(ctx, &verifiedpermissions.DeletePolicyTemplateInput{
+		PolicyStoreId:    fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+		PolicyTemplateId: fwflex.StringFromFramework(ctx, data.PolicyTemplateID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Policy Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourcePolicyTemplate) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	policyStoreID, policyTemplateID, err := policyTemplateParseResourceID(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("policy_store_id"), policyStoreID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("policy_template_id"), policyTemplateID)...)
+}
+
+type resourcePolicyTemplateData struct {
+	CreatedDate      types.String `tfsdk:"created_date"`
+	Description      types.String `tfsdk:"description"`
+	ID               types.String `tfsdk:"id"`
+	PolicyStoreID    types.String `tfsdk:"policy_store_id"`
+	PolicyTemplateID types.String `tfsdk:"policy_template_id"`
+	Statement        types.String `tfsdk:"statement"`
+}
+
+const policyTemplateResourceIDPartCount = 2
+
+func policyTemplateParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, policyTemplateResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findPolicyTemplateByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyTemplateID string) (*verifiedpermissions.GetPolicyTemplateOutput, error) {
+	input := &verifiedpermissions.GetPolicyTemplateInput{
+		PolicyStoreId:    aws.String(policyStoreID),
+		PolicyTemplateId: aws.String(policyTemplateID),
+	}
+
+	output, err := conn.GetPolicyTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PolicyTemplateId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
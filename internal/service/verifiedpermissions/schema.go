@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/maps"
+)
+
+// @FrameworkResource(name="Schema")
+func newResourceSchema(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSchema{}, nil
+}
+
+type resourceSchema struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceSchema) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_schema"
+}
+
+func (r *resourceSchema) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"namespaces": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "Cedar schema in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSchema) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.PutSchemaInput{
+		Definition:    expandSchemaDefinition(ctx, data.Definition),
+		PolicyStoreId: flex.StringFromFramework(ctx, data.PolicyStoreID),
+	}
+
+	output, err := conn.PutSchema(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Schema (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = flex.StringToFramework(ctx, output.PolicyStoreId)
+	data.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, output.Namespaces)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSchema) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findSchemaByPolicyStoreID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	value := aws.ToString(output.Schema)
+
+	// The schema is returned with whitespace removed and its keys reordered.
+	var old []schemaDefinitionData
+	if !data.Definition.IsNull() {
+		response.Diagnostics.Append(data.Definition.ElementsAs(ctx, &old, false)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+	if len(old) > 0 && verify.JSONStringsEqual(old[0].Value.ValueString(), value) {
+		value = old[0].Value.ValueString()
+	}
+
+	namespaces, err := schemaNamespaces(value)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Definition = flattenSchemaDefinition(ctx, value)
+	data.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, namespaces)
+	data.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSchema) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.PutSchemaInput{
+		Definition:    expandSchemaDefinition(ctx, new.Definition),
+		PolicyStoreId: flex.StringFromFramework(ctx, new.ID),
+	}
+
+	output, err := conn.PutSchema(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Schema (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, output.Namespaces)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSchema) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	// There is no API to delete a schema, so replace it with an empty one.
+	tflog.Debug(ctx, "deleting Verified Permissions Schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.PutSchema(ctx, &verifiedpermissions.PutSchemaInput{
+		Definition: &awstypes.SchemaDefinitionMemberCedarJson{
+			Value: "{}",
+		},
+		PolicyStoreId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceSchema) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("policy_store_id"), request.ID)...)
+}
+
+type resourceSchemaData struct {
+	Definition    types.List   `tfsdk:"definition"`
+	ID            types.String `tfsdk:"id"`
+	Namespaces    types.Set    `tfsdk:"namespaces"`
+	PolicyStoreID types.String `tfsdk:"policy_store_id"`
+}
+
+type schemaDefinitionData struct {
+	Value types.String `tfsdk:"value"`
+}
+
+var schemaDefinitionAttrTypes = map[string]attr.Type{
+	"value": types.StringType,
+}
+
+func expandSchemaDefinition(ctx context.Context, tfList types.List) awstypes.SchemaDefinition {
+	var data []schemaDefinitionData
+
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil
+	}
+
+	_ = fwdiag.Must(0, tfList.ElementsAs(ctx, &data, false))
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	return &awstypes.SchemaDefinitionMemberCedarJson{
+		Value: data[0].Value.ValueString(),
+	}
+}
+
+func flattenSchemaDefinition(_ context.Context, value string) types.List {
+	elemType := types.ObjectType{AttrTypes: schemaDefinitionAttrTypes}
+
+	return types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(schemaDefinitionAttrTypes, map[string]attr.Value{
+			"value": types.StringValue(value),
+		}),
+	})
+}
+
+// schemaNamespaces returns the namespaces declared in a Cedar schema in JSON format.
+// See https://docs.cedarpolicy.com/schema/json-schema.html.
+func schemaNamespaces(value string) ([]string, error) {
+	var v map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return nil, fmt.Errorf("parsing Cedar schema: %w", err)
+	}
+
+	return maps.Keys(v), nil
+}
+
+func findSchemaByPolicyStoreID(ctx context.Context, conn *verifiedpermissions.Client, id string) (*verifiedpermissions.GetSchemaOutput, error) {
+	input := &verifiedpermissions.GetSchemaInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetSchema(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleted schemas are empty.
+	if output == nil || output.Schema == nil || verify.JSONStringsEqual(aws.ToString(output.Schema), "{}") {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
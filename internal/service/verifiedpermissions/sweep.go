@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package verifiedpermissions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	// Deleting a policy store also deletes its schema, policies, policy templates and identity sources.
+	resource.AddTestSweepers("aws_verifiedpermissions_policy_store", &resource.Sweeper{
+		Name: "aws_verifiedpermissions_policy_store",
+		F:    sweepPolicyStores,
+	})
+}
+
+func sweepPolicyStores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.VerifiedPermissionsClient(ctx)
+	input := &verifiedpermissions.ListPolicyStoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := verifiedpermissions.NewListPolicyStoresPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Verified Permissions Policy Store sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Verified Permissions Policy Stores (%s): %w", region, err)
+		}
+
+		for _, v := range page.PolicyStores {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourcePolicyStore, client,
+				framework.NewAttribute("id", aws.ToString(v.PolicyStoreId)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Verified Permissions Policy Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package filter implements the filters which restrict the resources deleted by sweepers,
+// for example when sweeping a shared sandbox account.
+package filter
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Resource describes a resource to be swept.
+type Resource struct {
+	CreationTime *time.Time        `json:"creation_time,omitempty"`
+	ID           string            `json:"id"`
+	ResourceType string            `json:"resource_type,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	// TagsUnknown is set if the resource can be tagged but its tags could not be determined.
+	TagsUnknown bool `json:"tags_unknown,omitempty"`
+}
+
+// Tag matches a resource tag. An empty value matches any value.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+func (t Tag) match(tags map[string]string) bool {
+	v, ok := tags[t.Key]
+
+	return ok && (t.Value == "" || v == t.Value)
+}
+
+func (t Tag) String() string {
+	if t.Value == "" {
+		return t.Key
+	}
+
+	return t.Key + "=" + t.Value
+}
+
+// Filters restrict the resources deleted by sweepers.
+type Filters struct {
+	// Resources created more recently are not swept.
+	MinimumAge time.Duration
+	// Resources with this tag are never swept.
+	ProtectionTag *Tag
+	// If not empty, only resources with at least one of these tags are swept.
+	Tags []Tag
+}
+
+// FromEnv returns the filters configured via environment variables.
+func FromEnv() (*Filters, error) {
+	f := &Filters{}
+
+	if v := os.Getenv(envvar.SweepTagFilters); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			tag, err := parseTag(s)
+			if err != nil {
+				return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepTagFilters, err)
+			}
+			f.Tags = append(f.Tags, tag)
+		}
+	}
+
+	if v := os.Getenv(envvar.SweepMinimumAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinimumAge, err)
+		}
+		f.MinimumAge = d
+	}
+
+	if v := os.Getenv(envvar.SweepProtectionTag); v != "" {
+		tag, err := parseTag(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepProtectionTag, err)
+		}
+		f.ProtectionTag = &tag
+	}
+
+	return f, nil
+}
+
+func parseTag(s string) (Tag, error) {
+	k, v, _ := strings.Cut(strings.TrimSpace(s), "=")
+
+	if k == "" {
+		return Tag{}, fmt.Errorf("invalid tag filter (%s): empty key", s)
+	}
+
+	return Tag{Key: k, Value: v}, nil
+}
+
+// Enabled returns whether any filters are configured.
+func (f *Filters) Enabled() bool {
+	return f.MinimumAge > 0 || f.ProtectionTag != nil || len(f.Tags) > 0
+}
+
+// SkipReason returns the reason that the specified resource must not be swept, or an empty string if it can be swept.
+// A resource whose tags or creation time are not known is not swept if the corresponding filter is configured.
+func (f *Filters) SkipReason(r *Resource, now time.Time) string {
+	if f.ProtectionTag != nil {
+		if r.TagsUnknown {
+			return "tags unknown"
+		}
+
+		if f.ProtectionTag.match(r.Tags) {
+			return fmt.Sprintf("protected by tag %s", f.ProtectionTag)
+		}
+	}
+
+	if len(f.Tags) > 0 {
+		match := false
+		for _, tag := range f.Tags {
+			if tag.match(r.Tags) {
+				match = true
+				break
+			}
+		}
+
+		if !match {
+			return "no matching tag"
+		}
+	}
+
+	if f.MinimumAge > 0 {
+		if r.CreationTime == nil {
+			return "creation time unknown"
+		}
+
+		if age := now.Sub(*r.CreationTime); age < f.MinimumAge {
+			return fmt.Sprintf("created %s ago", age.Round(time.Second))
+		}
+	}
+
+	return ""
+}
+
+// TagsAttributeNames are the names of the attributes holding a resource's tags, in order of preference.
+var TagsAttributeNames = []string{
+	"tags_all",
+	"tags",
+}
+
+// CreationTimeAttributeNames are the names of the attributes commonly holding a resource's creation time.
+var CreationTimeAttributeNames = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"date_created",
+}
+
+// ParseCreationTime parses a creation time attribute value.
+// Returns nil if the value is not an RFC 3339 timestamp.
+func ParseCreationTime(v string) *time.Time {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
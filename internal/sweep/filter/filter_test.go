@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestFiltersSkipReason(t *testing.T) { //nolint:paralleltest // Sets environment variables.
+	t.Setenv(envvar.SweepTagFilters, "Owner=sweeper, Ephemeral")
+	t.Setenv(envvar.SweepMinimumAge, "2h")
+	t.Setenv(envvar.SweepProtectionTag, "DoNotDelete")
+
+	filters, err := FromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !filters.Enabled() {
+		t.Fatal("expected filters to be enabled")
+	}
+
+	now := time.Now()
+	old := now.Add(-3 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	testCases := map[string]struct {
+		resource Resource
+		expected string
+	}{
+		"matching tag key and value": {
+			resource: Resource{Tags: map[string]string{"Owner": "sweeper"}, CreationTime: &old},
+		},
+		"matching tag key": {
+			resource: Resource{Tags: map[string]string{"Ephemeral": "yes"}, CreationTime: &old},
+		},
+		"different tag value": {
+			resource: Resource{Tags: map[string]string{"Owner": "someone"}, CreationTime: &old},
+			expected: "no matching tag",
+		},
+		"no tags": {
+			resource: Resource{CreationTime: &old},
+			expected: "no matching tag",
+		},
+		"protected": {
+			resource: Resource{Tags: map[string]string{"Owner": "sweeper", "DoNotDelete": ""}, CreationTime: &old},
+			expected: "protected by tag DoNotDelete",
+		},
+		"tags unknown": {
+			resource: Resource{Tags: map[string]string{"Owner": "sweeper"}, TagsUnknown: true, CreationTime: &old},
+			expected: "tags unknown",
+		},
+		"too recent": {
+			resource: Resource{Tags: map[string]string{"Owner": "sweeper"}, CreationTime: &recent},
+			expected: "created 1h0m0s ago",
+		},
+		"creation time unknown": {
+			resource: Resource{Tags: map[string]string{"Owner": "sweeper"}},
+			expected: "creation time unknown",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			if got, want := filters.SkipReason(&testCase.resource, now), testCase.expected; got != want {
+				t.Errorf("SkipReason = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFromEnvInvalid(t *testing.T) { //nolint:paralleltest // Sets environment variables.
+	t.Setenv(envvar.SweepTagFilters, "Owner=sweeper,=value")
+
+	if _, err := FromEnv(); err == nil {
+		t.Error("expected error")
+	}
+
+	t.Setenv(envvar.SweepTagFilters, "")
+	t.Setenv(envvar.SweepMinimumAge, "2 hours")
+
+	if _, err := FromEnv(); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestParseCreationTime(t *testing.T) {
+	t.Parallel()
+
+	if got := ParseCreationTime("2023-07-01T12:00:00Z"); got == nil || got.Year() != 2023 {
+		t.Errorf("ParseCreationTime = %v", got)
+	}
+
+	if got := ParseCreationTime("1688212800"); got != nil {
+		t.Errorf("ParseCreationTime = %v, want nil", got)
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -16,6 +17,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	sweeptags "github.com/hashicorp/terraform-provider-aws/internal/sweep/tags"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -45,11 +49,174 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
-func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+// ResourceType returns the Terraform resource type of the resource to be swept.
+func (sr *sweepResource) ResourceType() string {
+	resource, err := sr.factory(context.Background())
+
+	if err != nil {
+		return ""
+	}
+
+	return resourceMetadata(context.Background(), resource).TypeName
+}
+
+func (sr *sweepResource) String() string {
+	var parts []string
+
+	for _, attr := range sr.attributes {
+		parts = append(parts, fmt.Sprintf("%s=%v", attr.path, attr.value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// Describe returns the resource's tags and creation time, if known.
+// The resource is read first if its schema includes these attributes.
+// Tags set by transparent tagging are listed via the service package, as the resource's Read handler doesn't return them.
+// Returns nil if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (*filter.Resource, error) {
+	ctx, resource, state, err := sr.newState(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &filter.Resource{
+		ID: sr.String(),
+	}
+
+	for _, attr := range sr.attributes {
+		if attr.path == "id" {
+			r.ID = fmt.Sprint(attr.value)
+		}
+	}
+
+	tagsAttribute, hasTags := firstAttribute(ctx, state, filter.TagsAttributeNames)
+	creationTimeAttribute, hasCreationTime := firstAttribute(ctx, state, filter.CreationTimeAttributeNames)
+
+	if !hasTags && !hasCreationTime {
+		return r, nil
+	}
+
+	// Resources using transparent tagging return their tags in the Context.
+	ctx = tftags.NewContext(ctx, nil, nil)
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return nil, fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
+
+	var values map[string]tftypes.Value
+	if err := response.State.Raw.As(&values); err != nil {
+		return nil, err
+	}
+
+	if hasTags {
+		r.Tags, r.TagsUnknown, err = sr.tags(ctx, values, tagsAttribute)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if v := values[creationTimeAttribute]; hasCreationTime && v.IsKnown() {
+		var s *string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+
+		if s != nil {
+			r.CreationTime = filter.ParseCreationTime(*s)
+		}
+	}
+
+	return r, nil
+}
+
+// tags returns the tags of the resource whose state values have just been read, or true if they are unknown.
+func (sr *sweepResource) tags(ctx context.Context, values map[string]tftypes.Value, tagsAttribute string) (map[string]string, bool, error) {
+	sp, spTags, registered := sweeptags.Lookup(ctx, sr.meta, sr.ResourceType())
+
+	inContext, _ := tftags.FromContext(ctx)
+
+	// Transparent tagging lists the tags of resources whose Read handler doesn't set them.
+	if spTags != nil && inContext.TagsOut.IsNone() {
+		if identifier := stringValue(values[spTags.IdentifierAttribute]); identifier != "" {
+			if err := sweeptags.List(ctx, sr.meta, sp, spTags, identifier); err != nil {
+				tflog.Warn(ctx, "Listing tags", map[string]any{
+					"err": err.Error(),
+				})
+			}
+		}
+	}
+
+	if inContext.TagsOut.IsSome() {
+		return inContext.TagsOut.UnwrapOrDefault().Map(), false, nil
+	}
+
+	// A resource not using transparent tagging sets its tags in state.
+	if spTags == nil {
+		if v := values[tagsAttribute]; v.IsKnown() && !v.IsNull() {
+			var elements map[string]tftypes.Value
+			if err := v.As(&elements); err != nil {
+				return nil, false, err
+			}
+
+			if len(elements) > 0 {
+				tags := make(map[string]string, len(elements))
+				for k, e := range elements {
+					var s string
+					if err := e.As(&s); err != nil {
+						return nil, false, err
+					}
+					tags[k] = s
+				}
+
+				return tags, false, nil
+			}
+		}
+
+		if registered {
+			return nil, false, nil
+		}
+	}
+
+	return nil, true, nil
+}
+
+// stringValue returns the value of a known string, or an empty string.
+func stringValue(v tftypes.Value) string {
+	var s *string
+
+	if !v.IsKnown() || v.As(&s) != nil || s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// firstAttribute returns the first of the specified attributes in the state's schema.
+func firstAttribute(ctx context.Context, state tfsdk.State, names []string) (string, bool) {
+	for _, k := range names {
+		if _, diags := state.Schema.TypeAtPath(ctx, path.Root(k)); !diags.HasError() {
+			return k, true
+		}
+	}
+
+	return "", false
+}
+
+// newState returns the resource to be swept, configured, and its state.
+func (sr *sweepResource) newState(ctx context.Context) (context.Context, fwresource.Resource, tfsdk.State, error) {
 	resource, err := sr.factory(ctx)
 
 	if err != nil {
-		return err
+		return ctx, nil, tfsdk.State{}, err
 	}
 
 	metadata := resourceMetadata(ctx, resource)
@@ -68,11 +235,48 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
+	return ctx, resource, state, nil
+}
+
+func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	filters, err := filter.FromEnv()
+	if err != nil {
+		return err
+	}
+
+	if filters.Enabled() {
+		r, err := sr.Describe(ctx)
+		if err != nil {
+			return err
+		}
+
+		if r == nil {
+			tflog.Info(ctx, "Resource already deleted", map[string]any{
+				"resource": sr.String(),
+			})
+			return nil
+		}
+
+		if reason := filters.SkipReason(r, time.Now()); reason != "" {
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"reason":   reason,
+				"resource": sr.String(),
+			})
+			return nil
+		}
+	}
+
+	ctx, resource, state, err := sr.newState(ctx)
+
+	if err != nil {
+		return err
+	}
+
 	tflog.Info(ctx, "Sweeping resource")
 
 	err = tfresource.Retry(ctx, timeout, func() *retry.RetryError {
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// testServicePackage lists the tags of resources using transparent tagging.
+type testServicePackage struct {
+	tags map[string]map[string]string // Keyed by identifier.
+}
+
+func (p *testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newIdentifierTaggedResource,
+			Name:    "Identifier Tagged",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+	}
+}
+
+func (p *testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (p *testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (p *testServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = types.Some(tftags.New(ctx, p.tags[identifier]))
+	}
+
+	return nil
+}
+
+// testResource is declared with @Tags(identifierAttribute="arn") if registered, and doesn't read its own tags.
+type testResource struct {
+	typeName string
+}
+
+func newIdentifierTaggedResource(context.Context) (fwresource.ResourceWithConfigure, error) {
+	return &testResource{typeName: "aws_test_identifier_tagged"}, nil
+}
+
+func newUnregisteredResource(context.Context) (fwresource.ResourceWithConfigure, error) {
+	return &testResource{typeName: "aws_test_unregistered"}, nil
+}
+
+func (r *testResource) Metadata(_ context.Context, request fwresource.MetadataRequest, response *fwresource.MetadataResponse) {
+	response.TypeName = r.typeName
+}
+
+func (r *testResource) Schema(_ context.Context, request fwresource.SchemaRequest, response *fwresource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *testResource) Configure(context.Context, fwresource.ConfigureRequest, *fwresource.ConfigureResponse) {
+}
+
+func (r *testResource) Create(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse) {
+}
+
+func (r *testResource) Read(ctx context.Context, request fwresource.ReadRequest, response *fwresource.ReadResponse) {
+	var id fwtypes.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("arn"), "arn:aws:test:::"+id.ValueString())...)
+}
+
+func (r *testResource) Update(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse) {
+}
+
+func (r *testResource) Delete(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse) {
+}
+
+func TestSweepResourceDescribe(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	meta := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			"test": &testServicePackage{
+				tags: map[string]map[string]string{
+					"arn:aws:test:::test-id": {"DoNotDelete": "true"},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		factory             func(context.Context) (fwresource.ResourceWithConfigure, error)
+		expectedTags        map[string]string
+		expectedTagsUnknown bool
+		expectedSkipReason  string
+	}{
+		"identifier tagged": {
+			factory:            newIdentifierTaggedResource,
+			expectedTags:       map[string]string{"DoNotDelete": "true"},
+			expectedSkipReason: "protected by tag DoNotDelete",
+		},
+		"unregistered": {
+			factory:             newUnregisteredResource,
+			expectedTagsUnknown: true,
+			expectedSkipReason:  "tags unknown",
+		},
+	}
+
+	filters := &filter.Filters{
+		ProtectionTag: &filter.Tag{Key: "DoNotDelete"},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := NewSweepResource(testCase.factory, meta, NewAttribute("id", "test-id")).Describe(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if r.ID != "test-id" {
+				t.Errorf("ID = %q, want %q", r.ID, "test-id")
+			}
+
+			if diff := cmp.Diff(r.Tags, testCase.expectedTags); diff != "" {
+				t.Errorf("unexpected Tags difference: %s", diff)
+			}
+
+			if got, want := r.TagsUnknown, testCase.expectedTagsUnknown; got != want {
+				t.Errorf("TagsUnknown = %t, want %t", got, want)
+			}
+
+			if got, want := filters.SkipReason(r, time.Now()), testCase.expectedSkipReason; got != want {
+				t.Errorf("SkipReason = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// TypedSweepable is a Sweepable whose Terraform resource type is known.
+// SweepOrchestrator deletes typed sweepables in dependency order.
+type TypedSweepable interface {
+	Sweepable
+	ResourceType() string
+}
+
+type typedSweepable struct {
+	Sweepable
+	resourceType string
+}
+
+// NewTypedSweepable returns a TypedSweepable of the specified Terraform resource type, e.g. "aws_subnet".
+func NewTypedSweepable(resourceType string, sweepable Sweepable) TypedSweepable {
+	return &typedSweepable{
+		Sweepable:    sweepable,
+		resourceType: resourceType,
+	}
+}
+
+func (s *typedSweepable) ResourceType() string {
+	return s.resourceType
+}
+
+func (s *typedSweepable) String() string {
+	if v, ok := s.Sweepable.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return ""
+}
+
+func (s *typedSweepable) Describe(ctx context.Context) (*filter.Resource, error) {
+	r, err := describe(ctx, s.Sweepable)
+
+	if r != nil {
+		r.ResourceType = s.resourceType
+	}
+
+	return r, err
+}
+
+// describable is implemented by the sweepables returned by sdk.NewSweepResource and framework.NewSweepResource.
+type describable interface {
+	Describe(ctx context.Context) (*filter.Resource, error)
+}
+
+// filterable returns whether filters can be applied to the specified sweepable.
+func filterable(sweepable Sweepable) bool {
+	if v, ok := sweepable.(*typedSweepable); ok {
+		sweepable = v.Sweepable
+	}
+
+	_, ok := sweepable.(describable)
+
+	return ok
+}
+
+// describe returns a description of the specified sweepable, or nil if the resource no longer exists.
+func describe(ctx context.Context, sweepable Sweepable) (*filter.Resource, error) {
+	if v, ok := sweepable.(describable); ok {
+		return v.Describe(ctx)
+	}
+
+	return &filter.Resource{
+		ID:           fmt.Sprint(sweepable),
+		ResourceType: resourceType(sweepable),
+	}, nil
+}
+
+var (
+	dependenciesLock sync.Mutex
+	dependencies     = make(map[string][]string) // Keyed by resource type.
+)
+
+// AddDependencies declares that resources of the specified type depend on resources of other types,
+// e.g. AddDependencies("aws_subnet", "aws_vpc").
+// SweepOrchestrator deletes all resources of the specified type before deleting resources of the types that it depends on.
+func AddDependencies(resourceType string, dependsOn ...string) {
+	dependenciesLock.Lock()
+	defer dependenciesLock.Unlock()
+
+	dependencies[resourceType] = append(dependencies[resourceType], dependsOn...)
+}
+
+// SweepOrchestrator deletes the specified sweepables.
+//
+// Sweepables are deleted in waves. Typed sweepables are placed in a later wave than the resources which depend on them,
+// as declared via AddDependencies. Sweepables whose type is unknown are placed in the first wave.
+// The sweepables in a wave are deleted concurrently, limited by the TF_AWS_SWEEP_WAVE_CONCURRENCY environment variable.
+// If the TF_AWS_SWEEP_DRY_RUN environment variable is set, the sweepables in each wave are logged, and reported if
+// the TF_AWS_SWEEP_DRY_RUN_REPORT environment variable is set, and not deleted.
+func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	dryRun := os.Getenv(envvar.SweepDryRun) != ""
+
+	concurrency := 0
+	if v := os.Getenv(envvar.SweepWaveConcurrency); v != "" {
+		var err error
+		concurrency, err = strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("environment variable %s: %w", envvar.SweepWaveConcurrency, err)
+		}
+	}
+
+	waves, err := sweepWaves(sweepables)
+	if err != nil {
+		return err
+	}
+
+	filters, err := filter.FromEnv()
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		return dryRunSweep(ctx, waves, filters)
+	}
+
+	var errs *multierror.Error
+
+	for i, wave := range waves {
+		ctx := tflog.SetField(ctx, "sweep_wave", i+1)
+
+		tflog.Debug(ctx, "Sweeping resources", map[string]any{
+			"count": len(wave),
+		})
+
+		// An error in one wave doesn't prevent later waves from being swept.
+		errs = multierror.Append(errs, sweepWave(ctx, wave, concurrency, filters, optFns...))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// dryRunReportEntry is a resource in the dry-run report.
+type dryRunReportEntry struct {
+	filter.Resource
+	SkipReason string `json:"skip_reason,omitempty"`
+	Wave       int    `json:"wave"`
+}
+
+var (
+	dryRunReportLock sync.Mutex
+	dryRunReport     = make([]dryRunReportEntry, 0) // All resources reported in the process.
+)
+
+// dryRunSweep logs the resources in each wave that would be swept, applying any filters, and adds them to the dry-run report.
+func dryRunSweep(ctx context.Context, waves [][]Sweepable, filters *filter.Filters) error {
+	var errs *multierror.Error
+	var entries []dryRunReportEntry
+
+	for i, wave := range waves {
+		ctx := tflog.SetField(ctx, "sweep_wave", i+1)
+
+		for _, sweepable := range wave {
+			r, err := describe(ctx, sweepable)
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("describing resource (%v): %w", sweepable, err))
+				continue
+			}
+
+			entry := dryRunReportEntry{
+				Wave: i + 1,
+			}
+			if r == nil {
+				entry.ID = fmt.Sprint(sweepable)
+				entry.SkipReason = "already deleted"
+			} else if filters.Enabled() && !filterable(sweepable) {
+				entry.Resource = *r
+				entry.SkipReason = skipReasonNotFilterable
+			} else {
+				entry.Resource = *r
+				entry.SkipReason = filters.SkipReason(r, time.Now())
+			}
+			if entry.ResourceType == "" {
+				entry.ResourceType = resourceType(sweepable)
+			}
+			entries = append(entries, entry)
+
+			if entry.SkipReason != "" {
+				tflog.Info(ctx, "Would skip resource", map[string]any{
+					"reason":        entry.SkipReason,
+					"resource":      entry.ID,
+					"resource_type": entry.ResourceType,
+				})
+			} else {
+				tflog.Info(ctx, "Would sweep resource", map[string]any{
+					"resource":      entry.ID,
+					"resource_type": entry.ResourceType,
+				})
+			}
+		}
+	}
+
+	if path := os.Getenv(envvar.SweepDryRunReport); path != "" {
+		if err := writeDryRunReport(path, entries); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// writeDryRunReport adds the specified entries to the dry-run report and writes the whole report to the specified file.
+// Sweepers run sequentially in one process, so the file is rewritten after each.
+func writeDryRunReport(path string, entries []dryRunReportEntry) error {
+	dryRunReportLock.Lock()
+	defer dryRunReportLock.Unlock()
+
+	dryRunReport = append(dryRunReport, entries...)
+
+	b, err := json.MarshalIndent(dryRunReport, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		return fmt.Errorf("writing sweeper dry-run report (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+// Sweepables other than those returned by sdk.NewSweepResource and framework.NewSweepResource
+// can't be filtered and so are skipped if any filters are configured.
+const skipReasonNotFilterable = "filters not supported"
+
+// sweepWave deletes the specified sweepables concurrently.
+// If concurrency is positive, at most that many deletions run at once.
+// Filters are applied by the sweepables themselves.
+func sweepWave(ctx context.Context, sweepables []Sweepable, concurrency int, filters *filter.Filters, optFns ...tfresource.OptionsFunc) error {
+	var g multierror.Group
+	var sem chan struct{}
+
+	if concurrency > 0 {
+		sem = make(chan struct{}, concurrency)
+	}
+
+	for _, sweepable := range sweepables {
+		sweepable := sweepable
+
+		if filters.Enabled() && !filterable(sweepable) {
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"reason":        skipReasonNotFilterable,
+				"resource":      fmt.Sprint(sweepable),
+				"resource_type": resourceType(sweepable),
+			})
+			continue
+		}
+
+		g.Go(func() error {
+			if sem != nil {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+			}
+
+			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// sweepWaves groups the specified sweepables into waves which can be deleted in order.
+// Returns an error if the declared dependencies between the sweepables' types contain a cycle.
+func sweepWaves(sweepables []Sweepable) ([][]Sweepable, error) {
+	g := depgraph.New()
+
+	for _, sweepable := range sweepables {
+		if v := resourceType(sweepable); v != "" {
+			g.AddNode(v)
+		}
+	}
+
+	if g.Len() == 0 {
+		if len(sweepables) == 0 {
+			return nil, nil
+		}
+
+		return [][]Sweepable{sweepables}, nil
+	}
+
+	// Add all declared dependencies so that ordering is transitive through types with no sweepables.
+	dependenciesLock.Lock()
+	for from, tos := range dependencies {
+		g.AddNode(from)
+		for _, to := range tos {
+			g.AddNode(to)
+			if err := g.AddDependency(from, to); err != nil {
+				dependenciesLock.Unlock()
+				return nil, err
+			}
+		}
+	}
+	dependenciesLock.Unlock()
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	// Dependencies are ordered first. Resources must be deleted before their dependencies,
+	// so a resource type's wave follows those of all the types which depend on it.
+	waveOf := make(map[string]int, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		node := order[i]
+		dependents, err := g.DirectDependentsOf(node)
+		if err != nil {
+			return nil, err
+		}
+
+		wave := 0
+		for _, dependent := range dependents {
+			if v := waveOf[dependent] + 1; v > wave {
+				wave = v
+			}
+		}
+		waveOf[node] = wave
+	}
+
+	var waves [][]Sweepable
+	for _, sweepable := range sweepables {
+		wave := waveOf[resourceType(sweepable)]
+		for len(waves) <= wave {
+			waves = append(waves, nil)
+		}
+		waves[wave] = append(waves[wave], sweepable)
+	}
+
+	// Remove waves with no sweepables.
+	i := 0
+	for _, wave := range waves {
+		if len(wave) > 0 {
+			waves[i] = wave
+			i++
+		}
+	}
+
+	return waves[:i], nil
+}
+
+func resourceType(sweepable Sweepable) string {
+	if v, ok := sweepable.(TypedSweepable); ok {
+		return v.ResourceType()
+	}
+
+	return ""
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package sweep_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// recordingSweepable records the deletion of a real sweep resource instead of calling AWS.
+type recordingSweepable struct {
+	sweep.TypedSweepable
+	deleted *[]string
+	lock    *sync.Mutex
+}
+
+func (s recordingSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	*s.deleted = append(*s.deleted, s.ResourceType())
+
+	return nil
+}
+
+// TestSweepOrchestrator_registeredDependencies sweeps the resource types swept together by the EC2 VPC and IAM Role sweepers,
+// checking that the dependencies registered by those sweepers delete dependents first.
+func TestSweepOrchestrator_registeredDependencies(t *testing.T) { //nolint:paralleltest // Sets environment variables.
+	t.Setenv(envvar.SweepWaveConcurrency, "1")
+
+	var lock sync.Mutex
+	var deleted []string
+	newSweepable := func(resourceType string, r *schema.Resource, id string) sweep.Sweepable {
+		d := r.Data(nil)
+		d.SetId(id)
+
+		return recordingSweepable{
+			TypedSweepable: sweep.NewTypedSweepResource(resourceType, r, d, nil),
+			deleted:        &deleted,
+			lock:           &lock,
+		}
+	}
+
+	// Listed in the order that the sweepers collect them.
+	sweepables := []sweep.Sweepable{
+		newSweepable("aws_vpc", tfec2.ResourceVPC(), "vpc-1"),
+		newSweepable("aws_subnet", tfec2.ResourceSubnet(), "subnet-1"),
+		newSweepable("aws_security_group", tfec2.ResourceSecurityGroup(), "sg-1"),
+		newSweepable("aws_network_interface", tfec2.ResourceNetworkInterface(), "eni-1"),
+		newSweepable("aws_iam_role", tfiam.ResourceRole(), "role-1"),
+		newSweepable("aws_iam_instance_profile", tfiam.ResourceInstanceProfile(), "instance-profile-1"),
+	}
+
+	if err := sweep.SweepOrchestrator(context.Background(), sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := len(deleted), len(sweepables); got != want {
+		t.Fatalf("deleted %d, want %d", got, want)
+	}
+
+	position := make(map[string]int, len(deleted))
+	for i, v := range deleted {
+		position[v] = i
+	}
+
+	for _, v := range []struct {
+		dependent, dependency string
+	}{
+		{"aws_network_interface", "aws_security_group"},
+		{"aws_network_interface", "aws_subnet"},
+		{"aws_security_group", "aws_vpc"},
+		{"aws_subnet", "aws_vpc"},
+		{"aws_iam_instance_profile", "aws_iam_role"},
+	} {
+		t.Run(fmt.Sprintf("%s before %s", v.dependent, v.dependency), func(t *testing.T) {
+			if position[v.dependent] > position[v.dependency] {
+				t.Errorf("deletion order %v", deleted)
+			}
+		})
+	}
+}
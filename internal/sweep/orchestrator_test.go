@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	id      string
+	deleted *[]string
+	lock    *sync.Mutex
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	*s.deleted = append(*s.deleted, s.id)
+
+	return nil
+}
+
+func (s testSweepable) String() string {
+	return s.id
+}
+
+func TestSweepWaves(t *testing.T) { //nolint:paralleltest // Modifies the global dependencies.
+	dependenciesLock.Lock()
+	saved := dependencies
+	dependencies = make(map[string][]string)
+	dependenciesLock.Unlock()
+	t.Cleanup(func() {
+		dependenciesLock.Lock()
+		dependencies = saved
+		dependenciesLock.Unlock()
+	})
+
+	AddDependencies("aws_subnet", "aws_vpc")
+	AddDependencies("aws_instance", "aws_subnet", "aws_security_group")
+	AddDependencies("aws_security_group", "aws_vpc")
+
+	var lock sync.Mutex
+	var deleted []string
+	newSweepable := func(resourceType, id string) Sweepable {
+		s := testSweepable{id: id, deleted: &deleted, lock: &lock}
+		if resourceType == "" {
+			return s
+		}
+		return NewTypedSweepable(resourceType, s)
+	}
+
+	sweepables := []Sweepable{
+		newSweepable("aws_vpc", "vpc-1"),
+		newSweepable("aws_instance", "i-1"),
+		newSweepable("", "untyped-1"),
+		newSweepable("aws_vpc", "vpc-2"),
+		newSweepable("aws_security_group", "sg-1"),
+	}
+
+	waves, err := sweepWaves(sweepables)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got [][]string
+	for _, wave := range waves {
+		var ids []string
+		for _, sweepable := range wave {
+			ids = append(ids, sweepable.(interface{ String() string }).String())
+		}
+		got = append(got, ids)
+	}
+
+	// aws_subnet has no sweepables, but aws_vpc is still ordered after it.
+	want := [][]string{
+		{"i-1", "untyped-1"},
+		{"sg-1"},
+		{"vpc-1", "vpc-2"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected waves (-got +want):\n%s", diff)
+	}
+
+	t.Setenv(envvar.SweepWaveConcurrency, "1")
+
+	if err := SweepOrchestrator(context.Background(), sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := len(deleted), len(sweepables); got != want {
+		t.Fatalf("deleted %d, want %d", got, want)
+	}
+	// Resources in the same wave are deleted in any order.
+	sort.Strings(deleted[0:2])
+	sort.Strings(deleted[3:])
+	if diff := cmp.Diff(deleted, []string{"i-1", "untyped-1", "sg-1", "vpc-1", "vpc-2"}); diff != "" {
+		t.Errorf("unexpected deletion order (-got +want):\n%s", diff)
+	}
+
+	deleted = nil
+	report := filepath.Join(t.TempDir(), "report.json")
+	t.Setenv(envvar.SweepDryRun, "1")
+	t.Setenv(envvar.SweepDryRunReport, report)
+	t.Setenv(envvar.SweepTagFilters, "Owner=sweeper")
+
+	if err := SweepOrchestrator(context.Background(), sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("dry run deleted %v", deleted)
+	}
+
+	b, err := os.ReadFile(report)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var entries []dryRunReportEntry
+	if err := json.Unmarshal(b, &entries); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := len(entries), len(sweepables); got != want {
+		t.Fatalf("reported %d, want %d", got, want)
+	}
+	for _, entry := range entries {
+		if entry.ID == "sg-1" && (entry.ResourceType != "aws_security_group" || entry.Wave != 2) {
+			t.Errorf("unexpected entry: %+v", entry)
+		}
+		// The test sweepables can't be described, so can't be filtered.
+		if got, want := entry.SkipReason, skipReasonNotFilterable; got != want {
+			t.Errorf("skip reason = %q, want %q", got, want)
+		}
+	}
+
+	t.Setenv(envvar.SweepDryRun, "")
+
+	if err := SweepOrchestrator(context.Background(), sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("filtered sweep deleted %v", deleted)
+	}
+
+	AddDependencies("aws_vpc", "aws_instance")
+
+	_, err = sweepWaves(sweepables)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "dependency cycle") {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -5,6 +5,7 @@ package sdk
 
 import (
 	"context"
+	"os"
 	"strings"
 	"time"
 
@@ -13,14 +14,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	sweeptags "github.com/hashicorp/terraform-provider-aws/internal/sweep/tags"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 type sweepResource struct {
-	d        *schema.ResourceData
-	meta     *conns.AWSClient
-	resource *schema.Resource
+	d            *schema.ResourceData
+	meta         *conns.AWSClient
+	resource     *schema.Resource
+	resourceType string
 }
 
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) *sweepResource {
@@ -31,9 +38,138 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// NewTypedSweepResource returns a sweepable for a resource of the specified Terraform resource type, e.g. "aws_vpc".
+// The Plugin SDK resource schema does not include the resource type, which is needed to sweep resources in dependency order.
+func NewTypedSweepResource(resourceType string, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) *sweepResource {
+	sr := NewSweepResource(resource, d, meta)
+	sr.resourceType = resourceType
+
+	return sr
+}
+
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
+// ResourceType returns the Terraform resource type of the resource to be swept, if known.
+func (sr *sweepResource) ResourceType() string {
+	return sr.resourceType
+}
+
+// Describe returns the resource's tags and creation time, if known.
+// If the resource's schema includes these attributes the resource is read first, leaving the resource data to be deleted unchanged.
+// Tags set by transparent tagging are listed via the service package, as the resource's Read handler doesn't return them.
+// Returns nil if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (*filter.Resource, error) {
+	d := sr.d
+
+	_, hasTags := firstAttribute(sr.resource, filter.TagsAttributeNames)
+	_, hasCreationTime := firstAttribute(sr.resource, filter.CreationTimeAttributeNames)
+
+	if hasTags || hasCreationTime {
+		d = sr.resource.Data(sr.d.State())
+
+		// Resources using transparent tagging return their tags in the Context.
+		ctx = tftags.NewContext(ctx, nil, nil)
+
+		if err := ReadResource(ctx, sr.resource, d, sr.meta); err != nil {
+			return nil, err
+		}
+
+		if d.Id() == "" {
+			return nil, nil
+		}
+	}
+
+	r := &filter.Resource{
+		ID:           sr.d.Id(),
+		ResourceType: sr.resourceType,
+	}
+
+	if hasTags {
+		r.Tags, r.TagsUnknown = sr.tags(ctx, d)
+	}
+
+	if k, ok := firstAttribute(sr.resource, filter.CreationTimeAttributeNames); ok {
+		if v, ok := d.Get(k).(string); ok && v != "" {
+			r.CreationTime = filter.ParseCreationTime(v)
+		}
+	}
+
+	return r, nil
+}
+
+// tags returns the tags of the resource that has just been read, or true if they are unknown.
+func (sr *sweepResource) tags(ctx context.Context, d *schema.ResourceData) (map[string]string, bool) {
+	resourceType := sr.resourceType
+	if resourceType == "" {
+		resourceType = sweeptags.SDKResourceType(ctx, sr.meta, sr.resource)
+	}
+	sp, spTags, registered := sweeptags.Lookup(ctx, sr.meta, resourceType)
+
+	inContext, _ := tftags.FromContext(ctx)
+
+	// Transparent tagging lists the tags of resources whose Read handler doesn't set them.
+	if spTags != nil && inContext.TagsOut.IsNone() {
+		if identifier := identifier(d, spTags.IdentifierAttribute); identifier != "" {
+			if err := sweeptags.List(ctx, sr.meta, sp, spTags, identifier); err != nil {
+				tflog.Warn(ctx, "Listing tags", map[string]any{
+					"err": err.Error(),
+				})
+			}
+		}
+	}
+
+	if inContext.TagsOut.IsSome() {
+		return inContext.TagsOut.UnwrapOrDefault().Map(), false
+	}
+
+	// A resource not using transparent tagging sets its tags in the resource data.
+	if spTags == nil {
+		k, _ := firstAttribute(sr.resource, filter.TagsAttributeNames)
+		if v, ok := d.Get(k).(map[string]any); ok && len(v) > 0 {
+			return flex.ExpandStringValueMap(v), false
+		}
+
+		if registered {
+			return nil, false
+		}
+	}
+
+	return nil, true
+}
+
+// identifier returns the value of the attribute identifying the resource for tagging.
+func identifier(d *schema.ResourceData, identifierAttribute string) string {
+	switch identifierAttribute {
+	case "":
+		return ""
+	case "id":
+		return d.Id()
+	default:
+		v, _ := d.Get(identifierAttribute).(string)
+		return v
+	}
+}
+
+// firstAttribute returns the first of the specified attributes in the resource's schema.
+func firstAttribute(resource *schema.Resource, names []string) (string, bool) {
+	for _, k := range names {
+		if _, ok := resource.SchemaMap()[k]; ok {
+			return k, true
+		}
+	}
+
+	return "", false
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
+	if skip, err := sr.skip(ctx); err != nil || skip {
+		return err
+	}
+
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		err := deleteResource(ctx, sr.resource, sr.d, sr.meta)
 
@@ -58,6 +194,37 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// skip returns whether the resource must not be deleted because of the filters set via environment variables.
+func (sr *sweepResource) skip(ctx context.Context) (bool, error) {
+	filters, err := filter.FromEnv()
+	if err != nil {
+		return false, err
+	}
+
+	if !filters.Enabled() {
+		return false, nil
+	}
+
+	r, err := sr.Describe(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if r == nil {
+		tflog.Info(ctx, "Resource already deleted")
+		return true, nil
+	}
+
+	if reason := filters.SkipReason(r, time.Now()); reason != "" {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": reason,
+		})
+		return true, nil
+	}
+
+	return false, nil
+}
+
 func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
@@ -74,8 +241,22 @@ func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.Re
 	return resource.Delete(d, meta)
 }
 
+// DeleteResource deletes the resource, applying any filters set via environment variables.
+// Nothing is deleted during a dry run.
+//
 // Deprecated: Create a list of Sweepables and pass them to SweepOrchestrator instead
 func DeleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
+	ctx = tflog.SetField(ctx, "id", d.Id())
+
+	if os.Getenv(envvar.SweepDryRun) != "" {
+		tflog.Info(ctx, "Dry run, not deleting resource")
+		return nil
+	}
+
+	if skip, err := NewSweepResource(resource, d, meta).skip(ctx); err != nil || skip {
+		return err
+	}
+
 	return deleteResource(ctx, resource, d, meta)
 }
 
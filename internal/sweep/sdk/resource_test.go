@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// testServicePackage lists the tags of resources using transparent tagging.
+type testServicePackage struct {
+	tags map[string]map[string]string // Keyed by identifier.
+}
+
+func (p *testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p *testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceIdentifierTagged,
+			TypeName: "aws_test_identifier_tagged",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  resourceSelfTagged,
+			TypeName: "aws_test_self_tagged",
+		},
+	}
+}
+
+func (p *testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (p *testServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = types.Some(tftags.New(ctx, p.tags[identifier]))
+	}
+
+	return nil
+}
+
+func testSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"tags_all": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// resourceIdentifierTagged is declared with @Tags(identifierAttribute="arn") and doesn't read its own tags.
+func resourceIdentifierTagged() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout:   resourceIdentifierTaggedRead,
+		DeleteWithoutTimeout: resourceNoopDelete,
+		Schema:               testSchema(),
+	}
+}
+
+func resourceIdentifierTaggedRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.Set("arn", "arn:aws:test:::"+d.Id())
+
+	return nil
+}
+
+// resourceSelfTagged reads its own tags.
+func resourceSelfTagged() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout:   resourceSelfTaggedRead,
+		DeleteWithoutTimeout: resourceNoopDelete,
+		Schema:               testSchema(),
+	}
+}
+
+func resourceSelfTaggedRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.Set("tags_all", map[string]string{"Owner": "sweeper"})
+
+	return nil
+}
+
+// resourceUnregistered isn't registered by any service package.
+func resourceUnregistered() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout:   resourceUnregisteredRead,
+		DeleteWithoutTimeout: resourceNoopDelete,
+		Schema:               testSchema(),
+	}
+}
+
+func resourceUnregisteredRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+
+func resourceNoopDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+
+func TestSweepResourceDescribe(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	meta := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			"test": &testServicePackage{
+				tags: map[string]map[string]string{
+					"arn:aws:test:::test-id": {"DoNotDelete": "true"},
+				},
+			},
+		},
+	}
+	newSweepResource := func(resourceType string, r *schema.Resource) *sweepResource {
+		d := r.Data(nil)
+		d.SetId("test-id")
+
+		return NewTypedSweepResource(resourceType, r, d, meta)
+	}
+
+	testCases := map[string]struct {
+		sweepResource       *sweepResource
+		expectedTags        map[string]string
+		expectedTagsUnknown bool
+		expectedSkipReason  string
+	}{
+		"identifier tagged": {
+			sweepResource:      newSweepResource("aws_test_identifier_tagged", resourceIdentifierTagged()),
+			expectedTags:       map[string]string{"DoNotDelete": "true"},
+			expectedSkipReason: "protected by tag DoNotDelete",
+		},
+		"identifier tagged, untyped": {
+			sweepResource:      newSweepResource("", resourceIdentifierTagged()),
+			expectedTags:       map[string]string{"DoNotDelete": "true"},
+			expectedSkipReason: "protected by tag DoNotDelete",
+		},
+		"self tagged": {
+			sweepResource: newSweepResource("aws_test_self_tagged", resourceSelfTagged()),
+			expectedTags:  map[string]string{"Owner": "sweeper"},
+		},
+		"unregistered": {
+			sweepResource:       newSweepResource("", resourceUnregistered()),
+			expectedTagsUnknown: true,
+			expectedSkipReason:  "tags unknown",
+		},
+	}
+
+	filters := &filter.Filters{
+		ProtectionTag: &filter.Tag{Key: "DoNotDelete"},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := testCase.sweepResource.Describe(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if r.ID != "test-id" {
+				t.Errorf("ID = %q, want %q", r.ID, "test-id")
+			}
+
+			if diff := cmp.Diff(r.Tags, testCase.expectedTags); diff != "" {
+				t.Errorf("unexpected Tags difference: %s", diff)
+			}
+
+			if got, want := r.TagsUnknown, testCase.expectedTagsUnknown; got != want {
+				t.Errorf("TagsUnknown = %t, want %t", got, want)
+			}
+
+			if got, want := filters.SkipReason(r, time.Now()), testCase.expectedSkipReason; got != want {
+				t.Errorf("SkipReason = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -7,4 +7,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
-var NewSweepResource = sdk.NewSweepResource
+var (
+	NewSweepResource      = sdk.NewSweepResource
+	NewTypedSweepResource = sdk.NewTypedSweepResource
+)
@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
@@ -101,20 +100,6 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
-func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
-	var g multierror.Group
-
-	for _, sweepable := range sweepables {
-		sweepable := sweepable
-
-		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
-		})
-	}
-
-	return g.Wait().ErrorOrNil()
-}
-
 // Deprecated: Usse awsv1.SkipSweepError
 //
 //nolint:stylecheck // It's not required for functions, so why for variables?
@@ -51,6 +51,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -83,6 +84,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/internetmonitor"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -97,6 +99,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
@@ -128,6 +131,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
@@ -144,6 +148,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
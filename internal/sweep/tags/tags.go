@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tags looks up the tags of resources to be swept whose tags are set by transparent tagging
+// rather than by the resource's Read handler.
+package tags
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type registration struct {
+	servicePackage conns.ServicePackage
+	tags           *types.ServicePackageResourceTags
+}
+
+type registry struct {
+	// Keyed by Terraform resource type.
+	registrations map[string]registration
+	// Plugin SDK resource types keyed by the resource's Read handler.
+	sdkResourceTypes map[uintptr]string
+}
+
+// registries caches the registry for each client's service packages.
+var registries sync.Map
+
+func registryFor(ctx context.Context, meta *conns.AWSClient) *registry {
+	if v, ok := registries.Load(meta); ok {
+		return v.(*registry)
+	}
+
+	r := &registry{
+		registrations:    make(map[string]registration),
+		sdkResourceTypes: make(map[uintptr]string),
+	}
+	ambiguous := make(map[uintptr]bool)
+
+	for _, sp := range meta.ServicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			r.registrations[v.TypeName] = registration{servicePackage: sp, tags: v.Tags}
+
+			if p := readHandler(v.Factory()); p != 0 {
+				if typeName, ok := r.sdkResourceTypes[p]; ok && typeName != v.TypeName {
+					ambiguous[p] = true
+				}
+				r.sdkResourceTypes[p] = v.TypeName
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			resource, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var response fwresource.MetadataResponse
+			resource.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+
+			r.registrations[response.TypeName] = registration{servicePackage: sp, tags: v.Tags}
+		}
+	}
+
+	// Resources built by a shared factory have the same Read handler.
+	for p := range ambiguous {
+		delete(r.sdkResourceTypes, p)
+	}
+
+	v, _ := registries.LoadOrStore(meta, r)
+
+	return v.(*registry)
+}
+
+// Lookup returns the service package registering the specified Terraform resource type and the resource's transparent tagging declaration, if any.
+// Returns false if no service package registers the resource type.
+func Lookup(ctx context.Context, meta *conns.AWSClient, resourceType string) (conns.ServicePackage, *types.ServicePackageResourceTags, bool) {
+	if meta == nil || resourceType == "" {
+		return nil, nil, false
+	}
+
+	v, ok := registryFor(ctx, meta).registrations[resourceType]
+
+	return v.servicePackage, v.tags, ok
+}
+
+// SDKResourceType returns the Terraform resource type of the specified Plugin SDK resource, found by matching the resource's Read handler.
+// Returns an empty string if the resource type cannot be determined.
+func SDKResourceType(ctx context.Context, meta *conns.AWSClient, resource *schema.Resource) string {
+	if meta == nil {
+		return ""
+	}
+
+	p := readHandler(resource)
+	if p == 0 {
+		return ""
+	}
+
+	return registryFor(ctx, meta).sdkResourceTypes[p]
+}
+
+func readHandler(resource *schema.Resource) uintptr {
+	switch {
+	case resource.ReadWithoutTimeout != nil:
+		return reflect.ValueOf(resource.ReadWithoutTimeout).Pointer()
+	case resource.ReadContext != nil:
+		return reflect.ValueOf(resource.ReadContext).Pointer()
+	case resource.Read != nil:
+		return reflect.ValueOf(resource.Read).Pointer()
+	}
+
+	return 0
+}
+
+// List lists the tags of the resource with the specified identifier using the service package's generic ListTags method and sets them in Context,
+// as transparent tagging does after the resource's Read handler.
+// The tags in Context are left unset if the service package has no generic ListTags method.
+func List(ctx context.Context, meta *conns.AWSClient, sp conns.ServicePackage, tags *types.ServicePackageResourceTags, identifier string) error {
+	if v, ok := sp.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		return v.ListTags(ctx, meta, identifier)
+	} else if v, ok := sp.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && tags.ResourceType != "" {
+		return v.ListTags(ctx, meta, identifier, tags.ResourceType)
+	}
+
+	return nil
+}
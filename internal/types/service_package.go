@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,20 +18,46 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIdentity represents resource-level identity information.
+type ServicePackageResourceIdentity struct {
+	Attributes []IdentityAttribute // Identity attributes, in import ID order
+}
+
+// IdentityAttribute represents a single resource identity attribute.
+type IdentityAttribute struct {
+	Name     string
+	Required bool // Required attributes are the resource's natural key and together form its import ID
+}
+
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsOverrideEnabled bool // Whether the resource's Region can be overridden using a top-level `region` argument
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
 	Factory func(context.Context) (datasource.DataSourceWithConfigure, error)
 	Name    string
 	Tags    *ServicePackageResourceTags
+	Region  *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
+	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
+	Name     string
+	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
+	Region   *ServicePackageResourceRegion
+}
+
+// ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
+// implemented by a service package.
+type ServicePackageEphemeralResource struct {
+	Factory func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	Name    string
-	Tags    *ServicePackageResourceTags
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -40,6 +67,7 @@ type ServicePackageSDKDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -49,4 +77,6 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
+	Region   *ServicePackageResourceRegion
 }
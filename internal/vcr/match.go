@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Request parameters which are generated for each request and so are ignored when matching.
+var idempotencyTokenParameters = []string{
+	"CallerReference",
+	"ClientRequestToken",
+	"ClientToken",
+	"CreatorRequestId",
+	"IdempotencyToken",
+}
+
+// Request and response parameters whose values are redacted before recording.
+var sensitiveParameters = []string{
+	"MasterUserPassword",
+	"Password",
+	"SecretAccessKey",
+	"SessionToken",
+}
+
+// Query string parameters used in pre-signed URLs.
+var signatureQueryParameters = []string{
+	"X-Amz-Credential",
+	"X-Amz-Date",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+}
+
+var requestHeadersToRemove = []string{
+	"Amz-Sdk-Invocation-Id",
+	"Amz-Sdk-Request",
+	"Authorization",
+	"X-Amz-Date",
+	"X-Amz-Security-Token",
+}
+
+var responseHeadersToRemove = []string{
+	"Set-Cookie",
+}
+
+const redacted = "REDACTED"
+
+var (
+	sensitiveFormRegexp   = regexp.MustCompile(fmt.Sprintf(`(?i)((?:^|&)(?:[^=&]*\.)?(?:%s)=)[^&]*`, strings.Join(sensitiveParameters, "|")))
+	sensitiveJSONRegexp   = regexp.MustCompile(fmt.Sprintf(`(?i)("(?:%s)"\s*:\s*)"(?:[^"\\]|\\.)*"`, strings.Join(sensitiveParameters, "|")))
+	sensitiveXMLRegexp    = regexp.MustCompile(fmt.Sprintf(`(?i)(<(?:%s)>)[^<]*(</)`, strings.Join(sensitiveParameters, "|")))
+	idempotencyXMLRegexp  = regexp.MustCompile(fmt.Sprintf(`<(?:%s)>[^<]*</(?:%s)>`, strings.Join(idempotencyTokenParameters, "|"), strings.Join(idempotencyTokenParameters, "|")))
+	sensitiveFormReplace  = "${1}" + redacted
+	sensitiveJSONReplace  = `${1}"` + redacted + `"`
+	sensitiveXMLReplace   = "${1}" + redacted + "${2}"
+	idempotencyXMLReplace = ""
+)
+
+// sanitizeBody redacts sensitive parameter values in a request or response body.
+func sanitizeBody(body string) string {
+	body = sensitiveFormRegexp.ReplaceAllString(body, sensitiveFormReplace)
+	body = sensitiveJSONRegexp.ReplaceAllString(body, sensitiveJSONReplace)
+	body = sensitiveXMLRegexp.ReplaceAllString(body, sensitiveXMLReplace)
+
+	return body
+}
+
+func sanitizeHeader(header http.Header, remove []string) http.Header {
+	header = header.Clone()
+	for _, v := range remove {
+		header.Del(v)
+	}
+
+	return header
+}
+
+func sanitizeURL(u *url.URL) string {
+	v := *u
+	query := v.Query()
+	for _, k := range signatureQueryParameters {
+		query.Del(k)
+	}
+	v.RawQuery = query.Encode()
+
+	return v.String()
+}
+
+// requestKey returns the key used to match a request to recorded interactions.
+// The key ignores request headers, which include signatures and SDK retry information,
+// parameter order and idempotency tokens.
+func requestKey(method, rawURL, contentType, body string) string {
+	var b strings.Builder
+
+	b.WriteString(method)
+	b.WriteString(" ")
+
+	if u, err := url.Parse(rawURL); err == nil {
+		query := u.Query()
+		for _, k := range signatureQueryParameters {
+			query.Del(k)
+		}
+		deleteIdempotencyTokens(query)
+
+		b.WriteString(strings.ToLower(u.Host))
+		b.WriteString(u.EscapedPath())
+		b.WriteString("?")
+		b.WriteString(query.Encode())
+	} else {
+		b.WriteString(rawURL)
+	}
+
+	b.WriteString("\n")
+	b.WriteString(normalizeBody(contentType, body))
+
+	return b.String()
+}
+
+func normalizeBody(contentType, body string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return body
+	}
+
+	// https://smithy.io/2.0/aws/protocols/index.html.
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body)
+		if err != nil {
+			return body
+		}
+		deleteIdempotencyTokens(values)
+
+		return values.Encode()
+
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		decoder := json.NewDecoder(strings.NewReader(body))
+		decoder.UseNumber()
+
+		var v any
+		if err := decoder.Decode(&v); err != nil {
+			return body
+		}
+		deleteIdempotencyTokensJSON(v)
+
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(v); err != nil {
+			return body
+		}
+
+		return buf.String()
+
+	case "application/xml", "text/xml":
+		return idempotencyXMLRegexp.ReplaceAllString(body, idempotencyXMLReplace)
+	}
+
+	return body
+}
+
+func deleteIdempotencyTokens(values url.Values) {
+	for k := range values {
+		if isIdempotencyTokenParameter(k[strings.LastIndex(k, ".")+1:]) {
+			values.Del(k)
+		}
+	}
+}
+
+func deleteIdempotencyTokensJSON(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isIdempotencyTokenParameter(k) {
+				delete(v, k)
+				continue
+			}
+			deleteIdempotencyTokensJSON(e)
+		}
+	case []any:
+		for _, e := range v {
+			deleteIdempotencyTokensJSON(e)
+		}
+	}
+}
+
+func isIdempotencyTokenParameter(name string) bool {
+	for _, v := range idempotencyTokenParameters {
+		if strings.EqualFold(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
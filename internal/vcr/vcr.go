@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package vcr implements an HTTP transport which records AWS API interactions to, and replays them from, a cassette directory.
+// This allows Terraform configurations to be tested without network access to AWS.
+package vcr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// Mode is the transport's mode.
+type Mode string
+
+const (
+	// ModeRecord sends requests to AWS and records the interactions.
+	ModeRecord Mode = "record"
+	// ModeReplay serves responses from recorded interactions. No requests are sent to AWS.
+	ModeReplay Mode = "replay"
+)
+
+// Modes returns the valid transport modes.
+func Modes() []string {
+	return []string{
+		string(ModeRecord),
+		string(ModeReplay),
+	}
+}
+
+const (
+	// EnvVarMode is the environment variable used to set the mode if not set in provider configuration.
+	EnvVarMode = "TF_AWS_MOCK_MODE"
+	// EnvVarCassetteDir is the environment variable used to set the cassette directory if not set in provider configuration.
+	EnvVarCassetteDir = "TF_AWS_MOCK_CASSETTE_DIR"
+)
+
+// ErrInteractionNotFound is returned in replay mode when no recorded interaction matches a request.
+var ErrInteractionNotFound = errors.New("recorded interaction not found")
+
+const cassetteFileExtension = ".yaml"
+
+// Transport is a recording or replaying http.RoundTripper.
+type Transport struct {
+	mode          Mode
+	realTransport http.RoundTripper
+
+	lock sync.Mutex
+	// Record mode.
+	cassette *cassette.Cassette
+	// Replay mode.
+	interactions []*cassette.Interaction
+	index        map[string][]int // Keyed by request key.
+	replayed     []bool
+}
+
+var (
+	transportsLock sync.Mutex
+	transports     = make(map[string]*Transport) // Keyed by absolute cassette directory.
+)
+
+// NewTransport returns a Transport for the specified mode and cassette directory.
+// Transports are shared by all provider instances in the process which use the same cassette directory.
+//
+// In record mode each provider process writes a new cassette to the directory, wrapping realTransport.
+// In replay mode the interactions from all cassettes in the directory are replayed in the order in which they were recorded.
+func NewTransport(mode Mode, dir string, realTransport http.RoundTripper) (*Transport, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	transportsLock.Lock()
+	defer transportsLock.Unlock()
+
+	if v, ok := transports[dir]; ok {
+		if v.mode != mode {
+			return nil, fmt.Errorf("cassette directory (%s) already in use in %s mode", dir, v.mode)
+		}
+
+		return v, nil
+	}
+
+	var transport *Transport
+
+	switch mode {
+	case ModeRecord:
+		transport, err = newRecordingTransport(dir, realTransport)
+	case ModeReplay:
+		transport, err = newReplayingTransport(dir)
+	default:
+		err = fmt.Errorf("unsupported mode: %s", mode)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	transports[dir] = transport
+
+	return transport, nil
+}
+
+func newRecordingTransport(dir string, realTransport http.RoundTripper) (*Transport, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating cassette directory (%s): %w", dir, err)
+	}
+
+	names, err := cassetteNames(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transport{
+		mode:          ModeRecord,
+		realTransport: realTransport,
+		cassette:      cassette.New(filepath.Join(dir, fmt.Sprintf("%06d", len(names)+1))),
+	}, nil
+}
+
+func newReplayingTransport(dir string) (*Transport, error) {
+	names, err := cassetteNames(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no cassettes found in %s", dir)
+	}
+
+	transport := &Transport{
+		mode:  ModeReplay,
+		index: make(map[string][]int),
+	}
+
+	for _, name := range names {
+		c, err := cassette.Load(name)
+		if err != nil {
+			return nil, fmt.Errorf("loading cassette (%s): %w", name, err)
+		}
+
+		for _, i := range c.Interactions {
+			key := requestKey(i.Request.Method, i.Request.URL, i.Request.Headers.Get("Content-Type"), i.Request.Body)
+			transport.index[key] = append(transport.index[key], len(transport.interactions))
+			transport.interactions = append(transport.interactions, i)
+		}
+	}
+
+	transport.replayed = make([]bool, len(transport.interactions))
+
+	return transport, nil
+}
+
+// cassetteNames returns the names of the cassettes in the specified directory, in the order in which they were recorded.
+func cassetteNames(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette directory (%s): %w", dir, err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != cassetteFileExtension {
+			continue
+		}
+		names = append(names, filepath.Join(dir, strings.TrimSuffix(entry.Name(), cassetteFileExtension)))
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Mode returns the transport's mode.
+func (t *Transport) Mode() Mode {
+	return t.mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if t.mode == ModeReplay {
+		return t.replay(r, body)
+	}
+
+	return t.record(r, body)
+}
+
+func (t *Transport) record(r *http.Request, reqBody []byte) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.realTransport.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+	duration := time.Since(start)
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	// Throttling, timeouts and server errors are retried by the AWS SDKs.
+	// Recording only the final attempt makes replays deterministic.
+	if isRetryable(resp.StatusCode, respBody) {
+		return resp, nil
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	sanitizedRespBody := sanitizeBody(string(respBody))
+	respHeaders := sanitizeHeader(resp.Header, responseHeadersToRemove)
+	if sanitizedRespBody != string(respBody) {
+		// Response checksums are no longer valid.
+		respHeaders.Del("X-Amz-Crc32")
+		if respHeaders.Get("Content-Length") != "" {
+			respHeaders.Set("Content-Length", strconv.Itoa(len(sanitizedRespBody)))
+		}
+	}
+
+	interaction := &cassette.Interaction{
+		Request: cassette.Request{
+			Proto:      r.Proto,
+			ProtoMajor: r.ProtoMajor,
+			ProtoMinor: r.ProtoMinor,
+			Host:       r.Host,
+			Body:       sanitizeBody(string(reqBody)),
+			Headers:    sanitizeHeader(r.Header, requestHeadersToRemove),
+			URL:        sanitizeURL(r.URL),
+			Method:     r.Method,
+		},
+		Response: cassette.Response{
+			Status:        resp.Status,
+			Code:          resp.StatusCode,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			ContentLength: int64(len(sanitizedRespBody)),
+			Uncompressed:  resp.Uncompressed,
+			Body:          sanitizedRespBody,
+			Headers:       respHeaders,
+			Duration:      duration,
+		},
+	}
+	interaction.Request.ContentLength = int64(len(interaction.Request.Body))
+	if contentType != "" {
+		interaction.Request.Headers.Set("Content-Type", contentType)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.cassette.AddInteraction(interaction)
+
+	// Terraform stops provider processes without notice, so save after each interaction.
+	if err := t.cassette.Save(); err != nil {
+		return nil, fmt.Errorf("saving cassette (%s): %w", t.cassette.File, err)
+	}
+
+	return resp, nil
+}
+
+func (t *Transport) replay(r *http.Request, body []byte) (*http.Response, error) {
+	key := requestKey(r.Method, r.URL.String(), r.Header.Get("Content-Type"), sanitizeBody(string(body)))
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	indices, ok := t.index[key]
+	if !ok {
+		return nil, &interactionNotFoundError{method: r.Method, url: r.URL.String()}
+	}
+
+	// Matching interactions are replayed in the order in which they were recorded.
+	// Once all have been replayed, the last is repeated. This tolerates additional reads, e.g. when polling for status.
+	i := indices[len(indices)-1]
+	for _, v := range indices {
+		if !t.replayed[v] {
+			i = v
+			break
+		}
+	}
+	t.replayed[i] = true
+
+	interaction := t.interactions[i]
+
+	return &http.Response{
+		Status:        interaction.Response.Status,
+		StatusCode:    interaction.Response.Code,
+		Proto:         interaction.Response.Proto,
+		ProtoMajor:    interaction.Response.ProtoMajor,
+		ProtoMinor:    interaction.Response.ProtoMinor,
+		ContentLength: interaction.Response.ContentLength,
+		Uncompressed:  interaction.Response.Uncompressed,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		Header:        interaction.Response.Headers.Clone(),
+		Request:       r,
+	}, nil
+}
+
+// interactionNotFoundError is returned in replay mode when no recorded interaction matches a request.
+// The AWS SDKs don't retry the request.
+type interactionNotFoundError struct {
+	method string
+	url    string
+}
+
+func (e *interactionNotFoundError) Error() string {
+	return fmt.Sprintf("%s: %s %s", ErrInteractionNotFound, e.method, e.url)
+}
+
+func (e *interactionNotFoundError) Unwrap() error {
+	return ErrInteractionNotFound
+}
+
+// RetryableError is used by the AWS SDK for Go v2.
+func (e *interactionNotFoundError) RetryableError() bool {
+	return false
+}
+
+// Temporary is used by the AWS SDK for Go v1.
+func (e *interactionNotFoundError) Temporary() bool {
+	return false
+}
+
+func isRetryable(statusCode int, body []byte) bool {
+	if statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError {
+		return true
+	}
+
+	if statusCode >= http.StatusBadRequest {
+		for _, v := range retryableErrorCodes {
+			if bytes.Contains(body, []byte(v)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Error codes retried by the AWS SDKs.
+var retryableErrorCodes = []string{
+	"BandwidthLimitExceeded",
+	"EC2ThrottledException",
+	"PriorRequestNotComplete",
+	"ProvisionedThroughputExceededException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestTimeout",
+	"SlowDown",
+	"Throttling",
+	"TooManyRequestsException",
+	"TransactionInProgressException",
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRequestKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType string
+		body1       string
+		body2       string
+		url1        string
+		url2        string
+		expected    bool
+	}{
+		"form parameter order": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body1:       "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&Version=2016-11-15",
+			body2:       "Version=2016-11-15&Action=CreateVpc&CidrBlock=10.0.0.0%2F16",
+			expected:    true,
+		},
+		"form idempotency token": {
+			contentType: "application/x-www-form-urlencoded",
+			body1:       "Action=RunInstances&ClientToken=a&Version=2016-11-15",
+			body2:       "Action=RunInstances&ClientToken=b&Version=2016-11-15",
+			expected:    true,
+		},
+		"form pagination": {
+			contentType: "application/x-www-form-urlencoded",
+			body1:       "Action=DescribeVpcs&Version=2016-11-15",
+			body2:       "Action=DescribeVpcs&NextToken=t1&Version=2016-11-15",
+			expected:    false,
+		},
+		"JSON key order": {
+			contentType: "application/x-amz-json-1.1",
+			body1:       `{"Name":"n1","Tags":[{"Key":"k1","Value":"v1"}]}`,
+			body2:       `{"Tags":[{"Value":"v1","Key":"k1"}],"Name":"n1"}`,
+			expected:    true,
+		},
+		"JSON idempotency token": {
+			contentType: "application/x-amz-json-1.1",
+			body1:       `{"ClientRequestToken":"a","Name":"n1"}`,
+			body2:       `{"ClientRequestToken":"b","Name":"n1"}`,
+			expected:    true,
+		},
+		"JSON different": {
+			contentType: "application/json",
+			body1:       `{"Name":"n1"}`,
+			body2:       `{"Name":"n2"}`,
+			expected:    false,
+		},
+		"XML idempotency token": {
+			contentType: "application/xml",
+			body1:       `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>a</CallerReference></CreateHostedZoneRequest>`,
+			body2:       `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>b</CallerReference></CreateHostedZoneRequest>`,
+			expected:    true,
+		},
+		"query order and signature": {
+			url1:     "https://bucket.s3.us-west-2.amazonaws.com/key?a=1&b=2&X-Amz-Signature=s1", //lintignore:AWSAT003
+			url2:     "https://bucket.s3.us-west-2.amazonaws.com/key?b=2&a=1&X-Amz-Signature=s2", //lintignore:AWSAT003
+			expected: true,
+		},
+		"different path": {
+			url1:     "https://bucket.s3.us-west-2.amazonaws.com/key1", //lintignore:AWSAT003
+			url2:     "https://bucket.s3.us-west-2.amazonaws.com/key2", //lintignore:AWSAT003
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			url1, url2 := testCase.url1, testCase.url2
+			if url1 == "" {
+				url1 = "https://ec2.us-west-2.amazonaws.com/" //lintignore:AWSAT003
+				url2 = url1
+			}
+
+			key1 := requestKey(http.MethodPost, url1, testCase.contentType, testCase.body1)
+			key2 := requestKey(http.MethodPost, url2, testCase.contentType, testCase.body2)
+
+			if got, want := key1 == key2, testCase.expected; got != want {
+				t.Errorf("match = %t, want %t\n%s\n%s", got, want, key1, key2)
+			}
+		})
+	}
+}
+
+func TestSanitizeBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body     string
+		expected string
+	}{
+		"form": {
+			body:     "Action=CreateDBInstance&MasterUserPassword=secret&DBInstanceIdentifier=db1",
+			expected: "Action=CreateDBInstance&MasterUserPassword=REDACTED&DBInstanceIdentifier=db1",
+		},
+		"JSON": {
+			body:     `{"Credentials":{"AccessKeyId":"AKIA","SecretAccessKey":"s\"ecret","SessionToken":"token"}}`,
+			expected: `{"Credentials":{"AccessKeyId":"AKIA","SecretAccessKey":"REDACTED","SessionToken":"REDACTED"}}`,
+		},
+		"XML": {
+			body:     `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey></Credentials>`,
+			expected: `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey></Credentials>`,
+		},
+		"nothing sensitive": {
+			body:     `{"Name":"n1"}`,
+			expected: `{"Name":"n1"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := sanitizeBody(testCase.body), testCase.expected; got != want {
+				t.Errorf("sanitizeBody = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	// Record.
+	var calls int
+	realTransport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+
+		code, body := http.StatusOK, fmt.Sprintf(`{"Call":%d}`, calls)
+		if calls == 2 {
+			// Throttled; retried by the AWS SDK.
+			code, body = http.StatusBadRequest, `{"__type":"ThrottlingException"}`
+		}
+
+		return &http.Response{
+			StatusCode: code,
+			Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+
+	recorder, err := NewTransport(ModeRecord, dir, realTransport)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := recorder.RoundTrip(newTestRequest(t, `{"Name":"n1","ClientToken":"`+fmt.Sprint(i)+`"}`)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	b, err := os.ReadFile(recorder.cassette.File)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Contains(string(b), "AWS4-HMAC-SHA256") {
+		t.Errorf("Authorization header recorded")
+	}
+	if strings.Contains(string(b), "ThrottlingException") {
+		t.Errorf("throttled response recorded")
+	}
+
+	// Forget the recording transport as if the provider process had exited.
+	transportsLock.Lock()
+	for k := range transports {
+		delete(transports, k)
+	}
+	transportsLock.Unlock()
+
+	// Replay.
+	replayer, err := NewTransport(ModeReplay, dir, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i, want := range []string{`{"Call":1}`, `{"Call":3}`, `{"Call":3}`} {
+		resp, err := replayer.RoundTrip(newTestRequest(t, `{"ClientToken":"x","Name":"n1"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := string(b); got != want {
+			t.Errorf("replay %d: response = %s, want %s", i, got, want)
+		}
+	}
+
+	if _, err := replayer.RoundTrip(newTestRequest(t, `{"Name":"n2"}`)); !errors.Is(err, ErrInteractionNotFound) {
+		t.Errorf("err = %v, want %v", err, ErrInteractionNotFound)
+	}
+}
+
+func newTestRequest(t *testing.T, body string) *http.Request {
+	t.Helper()
+
+	r, err := http.NewRequest(http.MethodPost, "https://logs.us-west-2.amazonaws.com/", strings.NewReader(body)) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	r.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKIA")
+
+	return r
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// CutImportIDRegion slices an import ID of the form `<id>@<region>` around the last "@".
+// The found result reports whether the import ID has a valid Region suffix.
+func CutImportIDRegion(s string) (string, string, bool) {
+	i := strings.LastIndex(s, "@")
+	if i < 1 {
+		return s, "", false
+	}
+
+	id, region := s[:i], s[i+1:]
+	if region == "" {
+		return s, "", false
+	}
+	if _, errs := ValidRegionName(region, names.AttrRegion); len(errs) > 0 {
+		return s, "", false
+	}
+
+	return id, region, true
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+func TestCutImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID       string
+		expectedID     string
+		expectedRegion string
+		expectedFound  bool
+	}{
+		"no Region": {
+			importID:   "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		"Region": {
+			importID:       "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+			expectedFound:  true,
+		},
+		"composite ID and Region": {
+			importID:       "p1,n1@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:     "p1,n1",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			expectedFound:  true,
+		},
+		"email address": {
+			importID:   "user@example.com",
+			expectedID: "user@example.com",
+		},
+		"email address and Region": {
+			importID:       "user@example.com@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "user@example.com",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+			expectedFound:  true,
+		},
+		"empty Region": {
+			importID:   "vpc-12345678@",
+			expectedID: "vpc-12345678@",
+		},
+		"Region only": {
+			importID:   "@eu-west-1", //lintignore:AWSAT003
+			expectedID: "@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, found := CutImportIDRegion(testCase.importID)
+
+			if got, want := found, testCase.expectedFound; got != want {
+				t.Errorf("found = %t, want %t", got, want)
+			}
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+		})
+	}
+}
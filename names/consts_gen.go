@@ -49,6 +49,7 @@ const (
 	CloudDirectory               = "clouddirectory"
 	CloudFormation               = "cloudformation"
 	CloudFront                   = "cloudfront"
+	CloudFrontKeyValueStore      = "cloudfrontkeyvaluestore"
 	CloudHSMV2                   = "cloudhsmv2"
 	CloudSearch                  = "cloudsearch"
 	CloudSearchDomain            = "cloudsearchdomain"
@@ -187,7 +188,6 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
@@ -33,8 +33,10 @@ const (
 	CloudWatchLogsEndpointID             = "logs"
 	ComprehendEndpointID                 = "comprehend"
 	ComputeOptimizerEndpointID           = "computeoptimizer"
+	DocDBElasticEndpointID               = "docdb-elastic"
 	DSEndpointID                         = "ds"
 	GlacierEndpointID                    = "glacier"
+	HealthLakeEndpointID                 = "healthlake"
 	IdentityStoreEndpointID              = "identitystore"
 	Inspector2EndpointID                 = "inspector2"
 	InternetMonitorEndpointID            = "internetmonitor"
@@ -52,6 +54,7 @@ const (
 	RolesAnywhereEndpointID              = "rolesanywhere"
 	Route53DomainsEndpointID             = "route53domains"
 	SchedulerEndpointID                  = "scheduler"
+	SecurityLakeEndpointID               = "securitylake"
 	SESV2EndpointID                      = "sesv2"
 	SSMEndpointID                        = "ssm"
 	SSMContactsEndpointID                = "ssm-contacts"
@@ -59,10 +62,35 @@ const (
 	SWFEndpointID                        = "swf"
 	TimestreamWriteEndpointID            = "ingest.timestream"
 	TranscribeEndpointID                 = "transcribe"
+	VerifiedPermissionsEndpointID        = "verifiedpermissions"
 	VPCLatticeEndpointID                 = "vpc-lattice"
 	XRayEndpointID                       = "xray"
 )
 
+// AWS partition IDs.
+const (
+	StandardPartitionID   = "aws"        // AWS Standard
+	ChinaPartitionID      = "aws-cn"     // AWS China
+	ISOPartitionID        = "aws-iso"    // AWS ISO (US)
+	ISOBPartitionID       = "aws-iso-b"  // AWS ISOB (US)
+	ISOEPartitionID       = "aws-iso-e"  // AWS ISOE (Europe)
+	ISOFPartitionID       = "aws-iso-f"  // AWS ISOF
+	USGovCloudPartitionID = "aws-us-gov" // AWS GovCloud (US)
+)
+
+// PartitionIDs returns the IDs of all known AWS partitions.
+func PartitionIDs() []string {
+	return []string{
+		StandardPartitionID,
+		ChinaPartitionID,
+		ISOPartitionID,
+		ISOBPartitionID,
+		ISOEPartitionID,
+		ISOFPartitionID,
+		USGovCloudPartitionID,
+	}
+}
+
 // Type ServiceDatum corresponds closely to columns in `names_data.csv` and are
 // described in detail in README.md.
 type ServiceDatum struct {
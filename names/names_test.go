@@ -140,7 +140,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"lookoutmetrics",
 		"lookoutvision",
 		"machinelearning",
-		"macie",
 		"managedblockchain",
 		"marketplacecatalog",
 		"marketplacecommerceanalytics",